@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// GetQueryCmd returns the CLI query commands for the bank module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryLockedSenders(),
+		GetCmdQueryUnlockedSenders(),
+		GetCmdQueryLockedReceivers(),
+		GetCmdQueryUnlockedReceivers(),
+		GetCmdQuerySendEnabled(),
+	)
+
+	return cmd
+}
+
+// addressDenomsResult is the shape shared by the responses of the four
+// locked/unlocked sender/receiver query methods.
+type addressDenomsResult struct {
+	Entries    []*types.AddressDenoms `json:"entries"`
+	Pagination *query.PageResponse    `json:"pagination,omitempty"`
+}
+
+// addressDenomsQueryFn invokes one of QueryClient's four locked/unlocked
+// sender/receiver methods.
+type addressDenomsQueryFn func(ctx context.Context, qc types.QueryClient, denom string, pageReq *query.PageRequest) ([]*types.AddressDenoms, *query.PageResponse, error)
+
+// addressDenomsCmd builds the CLI command shared by the four restriction
+// list queries, e.g. `banky query bank locked-senders --denom stake
+// --page-key <key>`.
+func addressDenomsCmd(use, short string, queryFn addressDenomsQueryFn) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: "Query the " + short + " list",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			qc := types.NewQueryClient(clientCtx)
+			entries, pageRes, err := queryFn(cmd.Context(), qc, denom, pageReq)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(addressDenomsResult{Entries: entries, Pagination: pageRes})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(out) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, use)
+	cmd.Flags().String(flagDenom, "", "restrict results to entries for this denom")
+
+	return cmd
+}
+
+// flagDenom is the --denom filter shared by the four address/denom query
+// commands below.
+const flagDenom = "denom"
+
+// GetCmdQueryLockedSenders implements the locked-senders query command.
+func GetCmdQueryLockedSenders() *cobra.Command {
+	return addressDenomsCmd("locked-senders", "locked senders", func(ctx context.Context, qc types.QueryClient, denom string, pageReq *query.PageRequest) ([]*types.AddressDenoms, *query.PageResponse, error) {
+		res, err := qc.LockedSenders(ctx, &types.QueryLockedSendersRequest{Denom: denom, Pagination: pageReq})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.LockedSenders, res.Pagination, nil
+	})
+}
+
+// GetCmdQueryUnlockedSenders implements the unlocked-senders query command.
+func GetCmdQueryUnlockedSenders() *cobra.Command {
+	return addressDenomsCmd("unlocked-senders", "unlocked senders", func(ctx context.Context, qc types.QueryClient, denom string, pageReq *query.PageRequest) ([]*types.AddressDenoms, *query.PageResponse, error) {
+		res, err := qc.UnlockedSenders(ctx, &types.QueryUnlockedSendersRequest{Denom: denom, Pagination: pageReq})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.UnlockedSenders, res.Pagination, nil
+	})
+}
+
+// GetCmdQueryLockedReceivers implements the locked-receivers query command.
+func GetCmdQueryLockedReceivers() *cobra.Command {
+	return addressDenomsCmd("locked-receivers", "locked receivers", func(ctx context.Context, qc types.QueryClient, denom string, pageReq *query.PageRequest) ([]*types.AddressDenoms, *query.PageResponse, error) {
+		res, err := qc.LockedReceivers(ctx, &types.QueryLockedReceiversRequest{Denom: denom, Pagination: pageReq})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.LockedReceivers, res.Pagination, nil
+	})
+}
+
+// GetCmdQueryUnlockedReceivers implements the unlocked-receivers query command.
+func GetCmdQueryUnlockedReceivers() *cobra.Command {
+	return addressDenomsCmd("unlocked-receivers", "unlocked receivers", func(ctx context.Context, qc types.QueryClient, denom string, pageReq *query.PageRequest) ([]*types.AddressDenoms, *query.PageResponse, error) {
+		res, err := qc.UnlockedReceivers(ctx, &types.QueryUnlockedReceiversRequest{Denom: denom, Pagination: pageReq})
+		if err != nil {
+			return nil, nil, err
+		}
+		return res.UnlockedReceivers, res.Pagination, nil
+	})
+}
+
+// GetCmdQuerySendEnabled implements the send-enabled query command.
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom...]",
+		Short: "Query for send enabled entries",
+		Long: `Query for SendEnabled entries.
+
+Without denoms, all SendEnabled entries are returned, paginated. Provide one
+or more denoms to restrict the results to just those.`,
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			qc := types.NewQueryClient(clientCtx)
+			res, err := qc.SendEnabled(cmd.Context(), &types.QuerySendEnabledRequest{Denoms: args, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(res)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(out) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send-enabled")
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Subspace defines the expected x/params subspace used by Migrate3to4 to
+// read out a module's pre-migration Params without importing the params
+// keeper directly.
+type Subspace interface {
+	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+}
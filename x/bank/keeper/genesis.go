@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// InitGenesis writes genState into the dedicated restriction KV stores
+// (see restrictions.go) entry by entry, rather than writing one large
+// Params blob into the x/params subspace, so a genesis file with tens of
+// thousands of restricted addresses doesn't need to be held as a single
+// decoded Params value.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.paramSpace.SetParamSet(ctx, &types.Params{DefaultSendEnabled: genState.Params.DefaultSendEnabled})
+
+	for _, se := range genState.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
+	loadAddressDenoms := func(entries []*types.AddressDenoms, setFn func(sdk.Context, sdk.AccAddress, string)) {
+		for _, ad := range entries {
+			addr, err := sdk.AccAddressFromBech32(ad.Address)
+			if err != nil {
+				panic(err)
+			}
+			for _, denom := range ad.Denoms {
+				setFn(ctx, addr, denom)
+			}
+		}
+	}
+
+	loadAddressDenoms(genState.LockedSenders, k.SetLockedSenderDenom)
+	loadAddressDenoms(genState.UnlockedSenders, k.SetUnlockedSenderDenom)
+	loadAddressDenoms(genState.LockedReceivers, k.SetLockedReceiverDenom)
+	loadAddressDenoms(genState.UnlockedReceivers, k.SetUnlockedReceiverDenom)
+
+	k.RebuildRestrictionIndex(ctx)
+}
+
+// ExportGenesis reads the dedicated restriction KV stores back out into a
+// GenesisState by streaming the prefix store iterators, rather than
+// reading a materialized Params.LockedSenders-style slice.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := &types.GenesisState{
+		Params: types.Params{DefaultSendEnabled: k.GetDefaultSendEnabled(ctx)},
+	}
+
+	k.IterateSendEnabled(ctx, func(denom string, enabled bool) bool {
+		genState.SendEnabled = append(genState.SendEnabled, types.NewSendEnabled(denom, enabled))
+		return false
+	})
+
+	genState.LockedSenders = k.lockedSenders().exportAddressDenoms(ctx)
+	genState.UnlockedSenders = k.unlockedSenders().exportAddressDenoms(ctx)
+	genState.LockedReceivers = k.lockedReceivers().exportAddressDenoms(ctx)
+	genState.UnlockedReceivers = k.unlockedReceivers().exportAddressDenoms(ctx)
+
+	return genState
+}
+
+// exportAddressDenoms groups r's addr/denom entries back into
+// AddressDenoms, in first-seen address order, for genesis export.
+func (r restrictionSet) exportAddressDenoms(ctx sdk.Context) []*types.AddressDenoms {
+	denomsByAddr := make(map[string][]string)
+	var order []string
+
+	r.iterateAll(ctx, func(addr, denom string) bool {
+		if _, seen := denomsByAddr[addr]; !seen {
+			order = append(order, addr)
+		}
+		denomsByAddr[addr] = append(denomsByAddr[addr], denom)
+		return false
+	})
+
+	entries := make([]*types.AddressDenoms, 0, len(order))
+	for _, addr := range order {
+		entries = append(entries, &types.AddressDenoms{Address: addr, Denoms: denomsByAddr[addr]})
+	}
+	return entries
+}
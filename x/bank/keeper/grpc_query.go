@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the bank QueryServer
+// interface for the provided Keeper, covering the restriction lists moved
+// into dedicated KV stores.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// SendEnabled implements types.QueryServer. When req.Denoms is non-empty it
+// returns exactly those entries (no pagination); otherwise it paginates
+// over every entry in the store.
+func (q queryServer) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(req.Denoms) > 0 {
+		resp := &types.QuerySendEnabledResponse{}
+		for _, denom := range req.Denoms {
+			if enabled, found := q.IsSendEnabled(ctx, denom); found {
+				resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(denom, enabled))
+			}
+		}
+		return resp, nil
+	}
+
+	entries, pageRes, err := q.GetAllSendEnabled(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySendEnabledResponse{SendEnabled: entries, Pagination: pageRes}, nil
+}
+
+// paginateAddressDenoms streams rs's addr/denom rows straight out of the
+// prefix store via query.Paginate. Each page row is its own AddressDenoms
+// with a single-element Denoms slice, since that's how the store is keyed;
+// callers that want them grouped per address should merge across pages. If
+// denom is non-empty, rows for any other denom are skipped; since the store
+// is addr-prefixed rather than denom-prefixed this still walks the whole
+// page range, it just doesn't include non-matching rows in the result.
+func (q queryServer) paginateAddressDenoms(ctx sdk.Context, rs restrictionSet, denom string, pageReq *query.PageRequest) ([]*types.AddressDenoms, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), rs.storePrefix)
+
+	var entries []*types.AddressDenoms
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		addrLen := int(key[0])
+		addr := sdk.AccAddress(key[1 : 1+addrLen]).String()
+		keyDenom := string(key[1+addrLen:])
+		if denom != "" && keyDenom != denom {
+			return nil
+		}
+		entries = append(entries, &types.AddressDenoms{Address: addr, Denoms: []string{keyDenom}})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, pageRes, nil
+}
+
+// LockedSenders implements types.QueryServer.
+func (q queryServer) LockedSenders(goCtx context.Context, req *types.QueryLockedSendersRequest) (*types.QueryLockedSendersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries, pageRes, err := q.paginateAddressDenoms(ctx, q.lockedSenders(), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryLockedSendersResponse{LockedSenders: entries, Pagination: pageRes}, nil
+}
+
+// UnlockedSenders implements types.QueryServer.
+func (q queryServer) UnlockedSenders(goCtx context.Context, req *types.QueryUnlockedSendersRequest) (*types.QueryUnlockedSendersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries, pageRes, err := q.paginateAddressDenoms(ctx, q.unlockedSenders(), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryUnlockedSendersResponse{UnlockedSenders: entries, Pagination: pageRes}, nil
+}
+
+// LockedReceivers implements types.QueryServer.
+func (q queryServer) LockedReceivers(goCtx context.Context, req *types.QueryLockedReceiversRequest) (*types.QueryLockedReceiversResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries, pageRes, err := q.paginateAddressDenoms(ctx, q.lockedReceivers(), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryLockedReceiversResponse{LockedReceivers: entries, Pagination: pageRes}, nil
+}
+
+// UnlockedReceivers implements types.QueryServer.
+func (q queryServer) UnlockedReceivers(goCtx context.Context, req *types.QueryUnlockedReceiversRequest) (*types.QueryUnlockedReceiversResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries, pageRes, err := q.paginateAddressDenoms(ctx, q.unlockedReceivers(), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryUnlockedReceiversResponse{UnlockedReceivers: entries, Pagination: pageRes}, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/keeper"
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+func TestQueryServer_SendEnabled(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	qs := keeper.NewQueryServerImpl(k)
+
+	k.SetSendEnabled(ctx, "atom", true)
+	k.SetSendEnabled(ctx, "osmo", false)
+
+	// Filtered by denom: no pagination.
+	res, err := qs.SendEnabled(sdk.WrapSDKContext(ctx), &types.QuerySendEnabledRequest{Denoms: []string{"osmo"}})
+	require.NoError(t, err)
+	require.Len(t, res.SendEnabled, 1)
+	require.Equal(t, "osmo", res.SendEnabled[0].Denom)
+	require.False(t, res.SendEnabled[0].Enabled)
+
+	// Unfiltered: paginated over everything in the store.
+	res, err = qs.SendEnabled(sdk.WrapSDKContext(ctx), &types.QuerySendEnabledRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.SendEnabled, 2)
+}
+
+func TestQueryServer_SendEnabled_NilRequest(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	qs := keeper.NewQueryServerImpl(k)
+
+	_, err := qs.SendEnabled(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
+
+func TestQueryServer_LockedSenders_DenomFilterAndPagination(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	qs := keeper.NewQueryServerImpl(k)
+
+	addrA := sdk.AccAddress("address-a------------")
+	addrB := sdk.AccAddress("address-b------------")
+	k.SetLockedSenderDenom(ctx, addrA, "stake")
+	k.SetLockedSenderDenom(ctx, addrA, "atom")
+	k.SetLockedSenderDenom(ctx, addrB, "stake")
+
+	res, err := qs.LockedSenders(sdk.WrapSDKContext(ctx), &types.QueryLockedSendersRequest{Denom: "stake"})
+	require.NoError(t, err)
+	require.Len(t, res.LockedSenders, 2)
+	for _, entry := range res.LockedSenders {
+		require.Equal(t, []string{"stake"}, entry.Denoms)
+	}
+
+	res, err = qs.LockedSenders(sdk.WrapSDKContext(ctx), &types.QueryLockedSendersRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.LockedSenders, 1)
+	require.NotNil(t, res.Pagination)
+}
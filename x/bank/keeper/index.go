@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// restrictionIndex is an in-memory mirror of the on-disk restriction KV
+// stores (see restrictions.go). SendCoins is on the hot path for every
+// transaction, so rather than hitting the underlying store for every
+// Is*Denom check we keep a copy in memory and fall back to disk whenever it
+// is not built: before it is first built (e.g. right after a node restart)
+// and after any write marks it stale, until the next EndBlock or
+// RebuildRestrictionIndex rebuilds it.
+type restrictionIndex struct {
+	mu sync.RWMutex
+
+	built bool
+
+	sendEnabled       map[string]bool
+	lockedSenders     map[string]map[string]struct{}
+	unlockedSenders   map[string]map[string]struct{}
+	lockedReceivers   map[string]map[string]struct{}
+	unlockedReceivers map[string]map[string]struct{}
+}
+
+func newRestrictionIndex() *restrictionIndex {
+	return &restrictionIndex{
+		sendEnabled:       make(map[string]bool),
+		lockedSenders:     make(map[string]map[string]struct{}),
+		unlockedSenders:   make(map[string]map[string]struct{}),
+		lockedReceivers:   make(map[string]map[string]struct{}),
+		unlockedReceivers: make(map[string]map[string]struct{}),
+	}
+}
+
+func (idx *restrictionIndex) addAddrDenom(set map[string]map[string]struct{}, addr, denom string) {
+	denoms, ok := set[addr]
+	if !ok {
+		denoms = make(map[string]struct{})
+		set[addr] = denoms
+	}
+	denoms[denom] = struct{}{}
+}
+
+func (idx *restrictionIndex) removeAddrDenom(set map[string]map[string]struct{}, addr, denom string) {
+	denoms, ok := set[addr]
+	if !ok {
+		return
+	}
+	delete(denoms, denom)
+	if len(denoms) == 0 {
+		delete(set, addr)
+	}
+}
+
+func (idx *restrictionIndex) hasAddrDenom(set map[string]map[string]struct{}, addr, denom string) bool {
+	denoms, ok := set[addr]
+	if !ok {
+		return false
+	}
+	_, ok = denoms[denom]
+	return ok
+}
+
+// replace swaps in a freshly rebuilt index, used by RebuildRestrictionIndex.
+func (idx *restrictionIndex) replace(fresh *restrictionIndex) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.built = true
+	idx.sendEnabled = fresh.sendEnabled
+	idx.lockedSenders = fresh.lockedSenders
+	idx.unlockedSenders = fresh.unlockedSenders
+	idx.lockedReceivers = fresh.lockedReceivers
+	idx.unlockedReceivers = fresh.unlockedReceivers
+}
+
+// markStale marks the index as not reflecting the store, so reads fall back
+// to disk until the next RebuildRestrictionIndex. Write paths call this
+// instead of mutating idx's maps directly: a write only lands in the store
+// inside ctx's KVStore, which may be a branched CacheMultiStore that gets
+// discarded (a simulate call, or a later message in the same tx failing) -
+// mutating the shared index at the same time would let that discarded write
+// permanently corrupt this node's cache relative to what's actually
+// committed, which is exactly the kind of divergence that breaks consensus.
+func (idx *restrictionIndex) markStale() {
+	idx.mu.Lock()
+	idx.built = false
+	idx.mu.Unlock()
+}
+
+// isStale reports whether the index needs rebuilding before it can be
+// trusted again.
+func (idx *restrictionIndex) isStale() bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return !idx.built
+}
+
+// RebuildRestrictionIndex rebuilds the in-memory restriction index from the
+// on-disk KV stores. Called from InitGenesis and from upgrade handlers
+// (e.g. after Migrate3to4 populates the stores for the first time).
+func (k Keeper) RebuildRestrictionIndex(ctx sdk.Context) {
+	fresh := newRestrictionIndex()
+
+	k.IterateSendEnabled(ctx, func(denom string, enabled bool) bool {
+		fresh.sendEnabled[denom] = enabled
+		return false
+	})
+
+	k.lockedSenders().iterateAll(ctx, func(addr, denom string) bool {
+		fresh.addAddrDenom(fresh.lockedSenders, addr, denom)
+		return false
+	})
+	k.unlockedSenders().iterateAll(ctx, func(addr, denom string) bool {
+		fresh.addAddrDenom(fresh.unlockedSenders, addr, denom)
+		return false
+	})
+	k.lockedReceivers().iterateAll(ctx, func(addr, denom string) bool {
+		fresh.addAddrDenom(fresh.lockedReceivers, addr, denom)
+		return false
+	})
+	k.unlockedReceivers().iterateAll(ctx, func(addr, denom string) bool {
+		fresh.addAddrDenom(fresh.unlockedReceivers, addr, denom)
+		return false
+	})
+
+	k.index.replace(fresh)
+}
+
+// EndBlock rebuilds the in-memory restriction index if a write this block
+// marked it stale. It must be wired into this module's EndBlocker so the
+// index only ever reflects state that made it through Commit - by the time
+// EndBlock runs, the current block's writes are either all going to commit
+// or the whole process is aborting, so there's no risk of indexing a write
+// that a simulate call or an in-tx failure later discards.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	if !k.index.isStale() {
+		return
+	}
+	k.RebuildRestrictionIndex(ctx)
+}
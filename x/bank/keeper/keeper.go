@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// Keeper defines the bank module keeper. It owns the dedicated restriction
+// KV stores (see restrictions.go) in addition to the legacy params
+// subspace, which still holds DefaultSendEnabled (written by InitGenesis and
+// UpdateParams, read by GetDefaultSendEnabled) and is where Migrate3to4
+// reads the pre-migration restriction lists from.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+
+	// authority is the address capable of executing MsgUpdateParams and the
+	// other governance-gated restriction messages. Typically the x/gov module
+	// account.
+	authority string
+
+	// index is the in-memory mirror of the restriction KV stores used to
+	// avoid a store read on every SendCoins restriction check. It is a
+	// pointer so it is shared by every copy of Keeper taken by value. Writes
+	// only mark it stale (see restrictionIndex.markStale) rather than
+	// updating it directly, since a write's ctx may be a branched
+	// CacheMultiStore that never commits; EndBlock is what actually rebuilds
+	// it, so it only ever reflects committed state.
+	index *restrictionIndex
+
+	// sendRestriction is the chain of SendRestrictionFn hooks run by
+	// SendCoins, see send_restriction.go.
+	sendRestriction *sendRestrictionFn
+}
+
+// NewKeeper returns a new bank Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	authority string,
+) Keeper {
+	k := Keeper{
+		cdc:             cdc,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		authority:       authority,
+		index:           newRestrictionIndex(),
+		sendRestriction: &sendRestrictionFn{},
+	}
+	k.AppendSendRestriction(defaultSendRestriction(k))
+	return k
+}
+
+// GetAuthority returns the address capable of executing governance-gated
+// messages on this module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
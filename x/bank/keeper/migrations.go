@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/exported"
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// Migrator is a wrapper around the bank keeper providing the upgrade
+// handlers used by the module manager's RegisterMigration.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator for the bank module. legacySubspace is
+// the module's x/params subspace, kept around solely so Migrate3to4 can read
+// whatever was stored there before the dedicated KV stores existed.
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
+}
+
+// Migrate3to4 moves SendEnabled, LockedSenders, UnlockedSenders,
+// LockedReceivers and UnlockedReceivers out of the x/params subspace and
+// into the dedicated prefix stores read by the Keeper methods in
+// restrictions.go. The legacy subspace entries are left untouched; nothing
+// reads them after this migration runs.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var legacyParams types.LegacyParams
+	m.legacySubspace.GetParamSet(ctx, &legacyParams)
+
+	for _, se := range legacyParams.SendEnabled {
+		m.keeper.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
+	for _, ad := range legacyParams.LockedSenders {
+		addr, err := sdk.AccAddressFromBech32(ad.Address)
+		if err != nil {
+			return err
+		}
+		for _, denom := range ad.Denoms {
+			m.keeper.SetLockedSenderDenom(ctx, addr, denom)
+		}
+	}
+
+	for _, ad := range legacyParams.UnlockedSenders {
+		addr, err := sdk.AccAddressFromBech32(ad.Address)
+		if err != nil {
+			return err
+		}
+		for _, denom := range ad.Denoms {
+			m.keeper.SetUnlockedSenderDenom(ctx, addr, denom)
+		}
+	}
+
+	for _, ad := range legacyParams.LockedReceivers {
+		addr, err := sdk.AccAddressFromBech32(ad.Address)
+		if err != nil {
+			return err
+		}
+		for _, denom := range ad.Denoms {
+			m.keeper.SetLockedReceiverDenom(ctx, addr, denom)
+		}
+	}
+
+	for _, ad := range legacyParams.UnlockedReceivers {
+		addr, err := sdk.AccAddressFromBech32(ad.Address)
+		if err != nil {
+			return err
+		}
+		for _, denom := range ad.Denoms {
+			m.keeper.SetUnlockedReceiverDenom(ctx, addr, denom)
+		}
+	}
+
+	m.keeper.RebuildRestrictionIndex(ctx)
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the bank MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) checkAuthority(signer string) error {
+	if k.authority != signer {
+		return govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.authority, signer)
+	}
+	return nil
+}
+
+// UpdateParams implements types.MsgServer. Like InitGenesis, only
+// DefaultSendEnabled is pushed into the legacy x/params subspace - the
+// SendEnabled/LockedSenders/UnlockedSenders/LockedReceivers/UnlockedReceivers
+// fields of msg.Params are written through the dedicated KV stores instead,
+// since those are what ValidateSendRestrictions and friends actually read at
+// runtime. A bare SetParamSet(&msg.Params) would leave every restriction
+// list as a governance-approved no-op.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.paramSpace.SetParamSet(ctx, &types.Params{DefaultSendEnabled: msg.Params.DefaultSendEnabled})
+
+	for _, se := range msg.Params.SendEnabled {
+		k.Keeper.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
+	loadAddressDenoms := func(entries []*types.AddressDenoms, setFn func(sdk.Context, sdk.AccAddress, string)) error {
+		for _, ad := range entries {
+			addr, err := sdk.AccAddressFromBech32(ad.Address)
+			if err != nil {
+				return fmt.Errorf("invalid address %q: %w", ad.Address, err)
+			}
+			for _, denom := range ad.Denoms {
+				setFn(ctx, addr, denom)
+			}
+		}
+		return nil
+	}
+
+	if err := loadAddressDenoms(msg.Params.LockedSenders, k.Keeper.SetLockedSenderDenom); err != nil {
+		return nil, err
+	}
+	if err := loadAddressDenoms(msg.Params.UnlockedSenders, k.Keeper.SetUnlockedSenderDenom); err != nil {
+		return nil, err
+	}
+	if err := loadAddressDenoms(msg.Params.LockedReceivers, k.Keeper.SetLockedReceiverDenom); err != nil {
+		return nil, err
+	}
+	if err := loadAddressDenoms(msg.Params.UnlockedReceivers, k.Keeper.SetUnlockedReceiverDenom); err != nil {
+		return nil, err
+	}
+
+	// The Set* calls above already marked the index stale; it's rebuilt from
+	// committed state in EndBlock, not here, since this message's writes
+	// could still be discarded (simulate, or a later message in the same tx
+	// failing) before they ever commit.
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetSendEnabled implements types.MsgServer. It updates the dedicated
+// send-enabled KV store entries directly, rather than rewriting the whole
+// Params blob.
+func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, se := range msg.SendEnabled {
+		k.Keeper.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+	for _, denom := range msg.UseDefaultFor {
+		k.Keeper.DeleteSendEnabled(ctx, denom)
+	}
+
+	return &types.MsgSetSendEnabledResponse{}, nil
+}
+
+// SetAddressDenoms implements types.MsgServer. It updates the dedicated
+// locked/unlocked sender and receiver KV store entries for the addresses
+// named in the message, leaving every other address untouched.
+func (k msgServer) SetAddressDenoms(goCtx context.Context, msg *types.MsgSetAddressDenoms) (*types.MsgSetAddressDenomsResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// apply sets every denom in ad.Denoms, or, when Denoms is empty, clears
+	// whatever denoms the address previously had registered.
+	apply := func(entries []*types.AddressDenoms, iterateFn func(sdk.Context, sdk.AccAddress, func(string) bool), setFn, deleteFn func(sdk.Context, sdk.AccAddress, string)) error {
+		for _, ad := range entries {
+			addr, err := sdk.AccAddressFromBech32(ad.Address)
+			if err != nil {
+				return fmt.Errorf("invalid address %q: %w", ad.Address, err)
+			}
+			if len(ad.Denoms) == 0 {
+				var stale []string
+				iterateFn(ctx, addr, func(denom string) bool {
+					stale = append(stale, denom)
+					return false
+				})
+				for _, denom := range stale {
+					deleteFn(ctx, addr, denom)
+				}
+				continue
+			}
+			for _, denom := range ad.Denoms {
+				setFn(ctx, addr, denom)
+			}
+		}
+		return nil
+	}
+
+	if err := apply(msg.LockedSenders, k.Keeper.IterateLockedSenderDenoms, k.Keeper.SetLockedSenderDenom, k.Keeper.DeleteLockedSenderDenom); err != nil {
+		return nil, err
+	}
+	if err := apply(msg.UnlockedSenders, k.Keeper.IterateUnlockedSenderDenoms, k.Keeper.SetUnlockedSenderDenom, k.Keeper.DeleteUnlockedSenderDenom); err != nil {
+		return nil, err
+	}
+	if err := apply(msg.LockedReceivers, k.Keeper.IterateLockedReceiverDenoms, k.Keeper.SetLockedReceiverDenom, k.Keeper.DeleteLockedReceiverDenom); err != nil {
+		return nil, err
+	}
+	if err := apply(msg.UnlockedReceivers, k.Keeper.IterateUnlockedReceiverDenoms, k.Keeper.SetUnlockedReceiverDenom, k.Keeper.DeleteUnlockedReceiverDenom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAddressDenomsResponse{}, nil
+}
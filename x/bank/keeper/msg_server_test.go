@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/keeper"
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+func TestMsgServer_UpdateParams(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	addr := sdk.AccAddress("a-locked-address-----")
+
+	msg := &types.MsgUpdateParams{
+		Authority: testAuthority,
+		Params: types.Params{
+			DefaultSendEnabled: false,
+			SendEnabled:        types.SendEnabledParams{types.NewSendEnabled("stake", true)},
+			LockedSenders:      []*types.AddressDenoms{{Address: addr.String(), Denoms: []string{"stake"}}},
+		},
+	}
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	require.False(t, k.GetDefaultSendEnabled(ctx))
+	enabled, found := k.IsSendEnabled(ctx, "stake")
+	require.True(t, found)
+	require.True(t, enabled)
+	require.True(t, k.IsLockedSenderDenom(ctx, addr, "stake"))
+}
+
+func TestMsgServer_UpdateParams_WrongAuthority(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: "not-the-authority"})
+	require.Error(t, err)
+}
+
+func TestMsgServer_SetSendEnabled(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	k.SetSendEnabled(ctx, "atom", true)
+
+	_, err := msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), &types.MsgSetSendEnabled{
+		Authority:     testAuthority,
+		SendEnabled:   []*types.SendEnabled{types.NewSendEnabled("stake", false)},
+		UseDefaultFor: []string{"atom"},
+	})
+	require.NoError(t, err)
+
+	enabled, found := k.IsSendEnabled(ctx, "stake")
+	require.True(t, found)
+	require.False(t, enabled)
+
+	_, found = k.IsSendEnabled(ctx, "atom")
+	require.False(t, found)
+}
+
+func TestMsgServer_SetAddressDenoms(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	addr := sdk.AccAddress("a-locked-address-----")
+
+	k.SetLockedSenderDenom(ctx, addr, "atom")
+
+	_, err := msgServer.SetAddressDenoms(sdk.WrapSDKContext(ctx), &types.MsgSetAddressDenoms{
+		Authority:     testAuthority,
+		LockedSenders: []*types.AddressDenoms{{Address: addr.String(), Denoms: nil}},
+	})
+	require.NoError(t, err)
+
+	// An empty Denoms slice clears whatever the address previously had.
+	require.False(t, k.IsLockedSenderDenom(ctx, addr, "atom"))
+}
+
+func TestMsgServer_SetAddressDenoms_InvalidAddress(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.SetAddressDenoms(sdk.WrapSDKContext(ctx), &types.MsgSetAddressDenoms{
+		Authority:     testAuthority,
+		LockedSenders: []*types.AddressDenoms{{Address: "not-a-bech32-address", Denoms: []string{"stake"}}},
+	})
+	require.Error(t, err)
+}
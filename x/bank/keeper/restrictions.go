@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// This file holds the dedicated KV storage that replaces the SendEnabled,
+// LockedSenders, UnlockedSenders, LockedReceivers and UnlockedReceivers
+// slices that used to be rewritten in full on every x/params change
+// proposal. Each entry is now its own key, so a single denom or address can
+// be added, updated or removed in O(1) instead of O(N) over the whole list.
+
+// SetSendEnabled sets whether sending is enabled for the given denom.
+func (k Keeper) SetSendEnabled(ctx sdk.Context, denom string, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.BoolValue{Value: enabled})
+	store.Set(types.SendEnabledKey(denom), bz)
+
+	k.index.markStale()
+}
+
+// IsSendEnabled returns the send enabled flag for denom and whether an
+// entry was found. Callers that want the module-wide default on a miss
+// should fall back to GetDefaultSendEnabled - see IsSendEnabledDenom, which
+// does exactly that and is what ValidateSendRestrictions uses. Reads the
+// in-memory index once it has been built (see RebuildRestrictionIndex),
+// falling back to the store on a cold cache.
+func (k Keeper) IsSendEnabled(ctx sdk.Context, denom string) (enabled bool, found bool) {
+	k.index.mu.RLock()
+	built := k.index.built
+	if built {
+		enabled, found = k.index.sendEnabled[denom]
+	}
+	k.index.mu.RUnlock()
+	if built {
+		return enabled, found
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SendEnabledKey(denom))
+	if bz == nil {
+		return false, false
+	}
+	var val gogotypes.BoolValue
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.Value, true
+}
+
+// GetDefaultSendEnabled returns the module-wide default send enabled flag
+// from the x/params subspace, used as the fallback for denoms with no
+// explicit IsSendEnabled entry. Returns false if the subspace has no value
+// stored yet.
+func (k Keeper) GetDefaultSendEnabled(ctx sdk.Context) bool {
+	var defaultSendEnabled bool
+	k.paramSpace.GetIfExists(ctx, types.KeyDefaultSendEnabled, &defaultSendEnabled)
+	return defaultSendEnabled
+}
+
+// IsSendEnabledDenom returns whether denom is enabled for sending, falling
+// back to GetDefaultSendEnabled when there is no explicit per-denom entry -
+// the same default-fallback behavior Params.SendEnabledDenom provides for
+// callers that only have a Params value in hand.
+func (k Keeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	if enabled, found := k.IsSendEnabled(ctx, denom); found {
+		return enabled
+	}
+	return k.GetDefaultSendEnabled(ctx)
+}
+
+// DeleteSendEnabled removes the send enabled entry for denom, reverting it
+// to the module-wide default.
+func (k Keeper) DeleteSendEnabled(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SendEnabledKey(denom))
+
+	k.index.markStale()
+}
+
+// IterateSendEnabled iterates over all denoms with a send enabled entry,
+// calling cb on each. Iteration stops if cb returns true.
+func (k Keeper) IterateSendEnabled(ctx sdk.Context, cb func(denom string, enabled bool) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val gogotypes.BoolValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if cb(string(iterator.Key()), val.Value) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabled returns a page of SendEnabled entries straight out of
+// the prefix store.
+func (k Keeper) GetAllSendEnabled(ctx sdk.Context, pageReq *query.PageRequest) ([]*types.SendEnabled, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+
+	var entries []*types.SendEnabled
+	pageRes, err := query.Paginate(store, pageReq, func(key, value []byte) error {
+		var val gogotypes.BoolValue
+		if err := k.cdc.Unmarshal(value, &val); err != nil {
+			return err
+		}
+		entries = append(entries, types.NewSendEnabled(string(key), val.Value))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, pageRes, nil
+}
+
+// restrictionSet returns the keeper methods for one of the four restriction
+// lists (locked/unlocked sender/receiver). They share identical semantics,
+// only the key prefix changes.
+type restrictionSet struct {
+	k           Keeper
+	entryKey    func(addr sdk.AccAddress, denom string) []byte
+	addrPrefix  func(addr sdk.AccAddress) []byte
+	storePrefix []byte
+	indexOf     func(idx *restrictionIndex) map[string]map[string]struct{}
+}
+
+func (k Keeper) lockedSenders() restrictionSet {
+	return restrictionSet{k, types.LockedSenderKey, types.LockedSenderAddressPrefix, types.LockedSenderPrefix,
+		func(idx *restrictionIndex) map[string]map[string]struct{} { return idx.lockedSenders }}
+}
+
+func (k Keeper) unlockedSenders() restrictionSet {
+	return restrictionSet{k, types.UnlockedSenderKey, types.UnlockedSenderAddressPrefix, types.UnlockedSenderPrefix,
+		func(idx *restrictionIndex) map[string]map[string]struct{} { return idx.unlockedSenders }}
+}
+
+func (k Keeper) lockedReceivers() restrictionSet {
+	return restrictionSet{k, types.LockedReceiverKey, types.LockedReceiverAddressPrefix, types.LockedReceiverPrefix,
+		func(idx *restrictionIndex) map[string]map[string]struct{} { return idx.lockedReceivers }}
+}
+
+func (k Keeper) unlockedReceivers() restrictionSet {
+	return restrictionSet{k, types.UnlockedReceiverKey, types.UnlockedReceiverAddressPrefix, types.UnlockedReceiverPrefix,
+		func(idx *restrictionIndex) map[string]map[string]struct{} { return idx.unlockedReceivers }}
+}
+
+func (r restrictionSet) set(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	ctx.KVStore(r.k.storeKey).Set(r.entryKey(addr, denom), []byte{})
+
+	r.k.index.markStale()
+}
+
+func (r restrictionSet) delete(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	ctx.KVStore(r.k.storeKey).Delete(r.entryKey(addr, denom))
+
+	r.k.index.markStale()
+}
+
+// has returns whether addr/denom is present, reading the in-memory index
+// once it has been built and falling back to the store on a cold cache.
+func (r restrictionSet) has(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	idx := r.k.index
+	idx.mu.RLock()
+	built := idx.built
+	var found bool
+	if built {
+		found = idx.hasAddrDenom(r.indexOf(idx), addr.String(), denom)
+	}
+	idx.mu.RUnlock()
+	if built {
+		return found
+	}
+
+	return ctx.KVStore(r.k.storeKey).Has(r.entryKey(addr, denom))
+}
+
+func (r restrictionSet) iterateAddress(ctx sdk.Context, addr sdk.AccAddress, cb func(denom string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(r.k.storeKey), r.addrPrefix(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// iterateAll walks every addr/denom entry in this restriction set, used by
+// RebuildRestrictionIndex to repopulate the in-memory index from disk.
+func (r restrictionSet) iterateAll(ctx sdk.Context, cb func(addr, denom string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(r.k.storeKey), r.storePrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		addrLen := int(key[0])
+		addr := sdk.AccAddress(key[1 : 1+addrLen]).String()
+		denom := string(key[1+addrLen:])
+		if cb(addr, denom) {
+			break
+		}
+	}
+}
+
+// SetLockedSenderDenom marks denom as locked for sending by addr.
+func (k Keeper) SetLockedSenderDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.lockedSenders().set(ctx, addr, denom)
+}
+
+// DeleteLockedSenderDenom removes the locked-sender entry for addr/denom.
+func (k Keeper) DeleteLockedSenderDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.lockedSenders().delete(ctx, addr, denom)
+}
+
+// IsLockedSenderDenom returns true if denom is locked for sending by addr.
+func (k Keeper) IsLockedSenderDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return k.lockedSenders().has(ctx, addr, denom)
+}
+
+// IterateLockedSenderDenoms iterates every denom locked for addr.
+func (k Keeper) IterateLockedSenderDenoms(ctx sdk.Context, addr sdk.AccAddress, cb func(denom string) (stop bool)) {
+	k.lockedSenders().iterateAddress(ctx, addr, cb)
+}
+
+// SetUnlockedSenderDenom marks denom as unlocked for sending by addr.
+func (k Keeper) SetUnlockedSenderDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.unlockedSenders().set(ctx, addr, denom)
+}
+
+// DeleteUnlockedSenderDenom removes the unlocked-sender entry for addr/denom.
+func (k Keeper) DeleteUnlockedSenderDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.unlockedSenders().delete(ctx, addr, denom)
+}
+
+// IsUnlockedSenderDenom returns true if denom is unlocked for sending by addr.
+func (k Keeper) IsUnlockedSenderDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return k.unlockedSenders().has(ctx, addr, denom)
+}
+
+// IterateUnlockedSenderDenoms iterates every denom unlocked for addr.
+func (k Keeper) IterateUnlockedSenderDenoms(ctx sdk.Context, addr sdk.AccAddress, cb func(denom string) (stop bool)) {
+	k.unlockedSenders().iterateAddress(ctx, addr, cb)
+}
+
+// SetLockedReceiverDenom marks denom as locked for receiving by addr.
+func (k Keeper) SetLockedReceiverDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.lockedReceivers().set(ctx, addr, denom)
+}
+
+// DeleteLockedReceiverDenom removes the locked-receiver entry for addr/denom.
+func (k Keeper) DeleteLockedReceiverDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.lockedReceivers().delete(ctx, addr, denom)
+}
+
+// IsLockedReceiverDenom returns true if denom is locked for receiving by addr.
+func (k Keeper) IsLockedReceiverDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return k.lockedReceivers().has(ctx, addr, denom)
+}
+
+// IterateLockedReceiverDenoms iterates every denom locked for addr.
+func (k Keeper) IterateLockedReceiverDenoms(ctx sdk.Context, addr sdk.AccAddress, cb func(denom string) (stop bool)) {
+	k.lockedReceivers().iterateAddress(ctx, addr, cb)
+}
+
+// SetUnlockedReceiverDenom marks denom as unlocked for receiving by addr.
+func (k Keeper) SetUnlockedReceiverDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.unlockedReceivers().set(ctx, addr, denom)
+}
+
+// DeleteUnlockedReceiverDenom removes the unlocked-receiver entry for addr/denom.
+func (k Keeper) DeleteUnlockedReceiverDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	k.unlockedReceivers().delete(ctx, addr, denom)
+}
+
+// IsUnlockedReceiverDenom returns true if denom is unlocked for receiving by addr.
+func (k Keeper) IsUnlockedReceiverDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return k.unlockedReceivers().has(ctx, addr, denom)
+}
+
+// IterateUnlockedReceiverDenoms iterates every denom unlocked for addr.
+func (k Keeper) IterateUnlockedReceiverDenoms(ctx sdk.Context, addr sdk.AccAddress, cb func(denom string) (stop bool)) {
+	k.unlockedReceivers().iterateAddress(ctx, addr, cb)
+}
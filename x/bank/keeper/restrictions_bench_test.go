@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/keeper"
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// newBenchKeeper wires up a bank Keeper backed by an in-memory DB, with
+// n addresses each locked for "stake", used by BenchmarkSendCoins_Restricted10k
+// below to measure the cost of the restriction lookups SendCoins makes on
+// every transfer.
+func newBenchKeeper(b *testing.B, n int) (keeper.Keeper, sdk.Context, []sdk.AccAddress) {
+	b.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(b, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := codec.NewProtoCodec(nil)
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, storetypes.NewTransientStoreKey("params_transient_test"), types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	k := keeper.NewKeeper(cdc, storeKey, paramSpace, "authority")
+
+	addrs := make([]sdk.AccAddress, n)
+	for i := 0; i < n; i++ {
+		addr := sdk.AccAddress(fmt.Sprintf("bench-addr-%d--------", i))[:20]
+		addrs[i] = addr
+		k.SetLockedSenderDenom(ctx, addr, "stake")
+	}
+	k.RebuildRestrictionIndex(ctx)
+
+	return k, ctx, addrs
+}
+
+// BenchmarkSendCoins_Restricted10k measures Is*Denom lookup cost against a
+// restriction set with 10k locked addresses - the scenario that used to
+// require an O(N) scan over Params.LockedSenders on every SendCoins call.
+func BenchmarkSendCoins_Restricted10k(b *testing.B) {
+	k, ctx, addrs := newBenchKeeper(b, 10_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		addr := addrs[i%len(addrs)]
+		_ = k.IsLockedSenderDenom(ctx, addr, "stake")
+	}
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendEnabled_SetIsDelete(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	_, found := k.IsSendEnabled(ctx, "stake")
+	require.False(t, found)
+
+	k.SetSendEnabled(ctx, "stake", false)
+	enabled, found := k.IsSendEnabled(ctx, "stake")
+	require.True(t, found)
+	require.False(t, enabled)
+
+	k.DeleteSendEnabled(ctx, "stake")
+	_, found = k.IsSendEnabled(ctx, "stake")
+	require.False(t, found)
+}
+
+func TestSendEnabled_IterateAndGetAll(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	k.SetSendEnabled(ctx, "atom", true)
+	k.SetSendEnabled(ctx, "osmo", false)
+
+	seen := make(map[string]bool)
+	k.IterateSendEnabled(ctx, func(denom string, enabled bool) bool {
+		seen[denom] = enabled
+		return false
+	})
+	require.Equal(t, map[string]bool{"atom": true, "osmo": false}, seen)
+
+	entries, _, err := k.GetAllSendEnabled(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestLockedUnlockedSenderDenom_SetIsDelete(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	addr := sdk.AccAddress("an-address-----------")
+
+	require.False(t, k.IsLockedSenderDenom(ctx, addr, "stake"))
+
+	k.SetLockedSenderDenom(ctx, addr, "stake")
+	require.True(t, k.IsLockedSenderDenom(ctx, addr, "stake"))
+
+	k.SetUnlockedSenderDenom(ctx, addr, "stake")
+	require.True(t, k.IsUnlockedSenderDenom(ctx, addr, "stake"))
+
+	k.DeleteLockedSenderDenom(ctx, addr, "stake")
+	require.False(t, k.IsLockedSenderDenom(ctx, addr, "stake"))
+
+	k.DeleteUnlockedSenderDenom(ctx, addr, "stake")
+	require.False(t, k.IsUnlockedSenderDenom(ctx, addr, "stake"))
+}
+
+func TestLockedUnlockedReceiverDenom_SetIsDelete(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	addr := sdk.AccAddress("an-address-----------")
+
+	require.False(t, k.IsLockedReceiverDenom(ctx, addr, "stake"))
+
+	k.SetLockedReceiverDenom(ctx, addr, "stake")
+	require.True(t, k.IsLockedReceiverDenom(ctx, addr, "stake"))
+
+	k.SetUnlockedReceiverDenom(ctx, addr, "stake")
+	require.True(t, k.IsUnlockedReceiverDenom(ctx, addr, "stake"))
+
+	k.DeleteLockedReceiverDenom(ctx, addr, "stake")
+	require.False(t, k.IsLockedReceiverDenom(ctx, addr, "stake"))
+
+	k.DeleteUnlockedReceiverDenom(ctx, addr, "stake")
+	require.False(t, k.IsUnlockedReceiverDenom(ctx, addr, "stake"))
+}
+
+func TestIterateLockedSenderDenoms(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	addr := sdk.AccAddress("an-address-----------")
+	other := sdk.AccAddress("another-address------")
+
+	k.SetLockedSenderDenom(ctx, addr, "atom")
+	k.SetLockedSenderDenom(ctx, addr, "osmo")
+	k.SetLockedSenderDenom(ctx, other, "stake")
+
+	var denoms []string
+	k.IterateLockedSenderDenoms(ctx, addr, func(denom string) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+	require.ElementsMatch(t, []string{"atom", "osmo"}, denoms)
+}
+
+func TestIsSendEnabledDenom_DefaultFallback(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	// No entries set yet: DefaultSendEnabled from the x/params subspace
+	// hasn't been initialized either, so GetDefaultSendEnabled returns the
+	// zero value (false).
+	require.False(t, k.IsSendEnabledDenom(ctx, "stake"))
+
+	k.SetSendEnabled(ctx, "stake", true)
+	require.True(t, k.IsSendEnabledDenom(ctx, "stake"))
+
+	k.DeleteSendEnabled(ctx, "stake")
+	require.False(t, k.IsSendEnabledDenom(ctx, "stake"))
+}
+
+func TestRebuildRestrictionIndex_ColdCacheMatchesStore(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	addr := sdk.AccAddress("an-address-----------")
+
+	k.SetSendEnabled(ctx, "stake", false)
+	k.SetLockedSenderDenom(ctx, addr, "stake")
+
+	enabledBefore, foundBefore := k.IsSendEnabled(ctx, "stake")
+	lockedBefore := k.IsLockedSenderDenom(ctx, addr, "stake")
+
+	k.RebuildRestrictionIndex(ctx)
+
+	enabledAfter, foundAfter := k.IsSendEnabled(ctx, "stake")
+	require.Equal(t, foundBefore, foundAfter)
+	require.Equal(t, enabledBefore, enabledAfter)
+	require.Equal(t, lockedBefore, k.IsLockedSenderDenom(ctx, addr, "stake"))
+}
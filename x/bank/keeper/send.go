@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// ValidateSendRestrictions runs the per-denom checks SendCoins must pass
+// before moving amt from fromAddr to toAddr: the denom must be send-enabled,
+// fromAddr must not be locked (or must be explicitly unlocked) as a sender,
+// and toAddr must not be locked (or must be explicitly unlocked) as a
+// receiver. It is the hot path for every transfer, which is why the checks
+// it calls are backed by the in-memory restrictionIndex rather than the raw
+// KV store.
+func (k Keeper) ValidateSendRestrictions(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		denom := coin.Denom
+
+		if !k.IsSendEnabledDenom(ctx, denom) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", denom)
+		}
+
+		if k.IsLockedSenderDenom(ctx, fromAddr, denom) && !k.IsUnlockedSenderDenom(ctx, fromAddr, denom) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s is locked from sending %s", fromAddr, denom)
+		}
+
+		if k.IsLockedReceiverDenom(ctx, toAddr, denom) && !k.IsUnlockedReceiverDenom(ctx, toAddr, denom) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s is locked from receiving %s", toAddr, denom)
+		}
+	}
+	return nil
+}
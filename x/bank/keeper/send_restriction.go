@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// sendRestrictionFn wraps the composed restriction chain in a struct held by
+// pointer on Keeper, so Append/Prepend/Clear mutate the same chain no
+// matter which copy of the (value-receiver) Keeper the caller holds.
+type sendRestrictionFn struct {
+	fn types.SendRestrictionFn
+}
+
+// AppendSendRestriction adds the given restriction to the end of the chain,
+// so it runs after every restriction already registered.
+func (k Keeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = k.sendRestriction.fn.Then(restriction)
+}
+
+// PrependSendRestriction adds the given restriction to the front of the
+// chain, so it runs before every restriction already registered.
+func (k Keeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = restriction.Then(k.sendRestriction.fn)
+}
+
+// ClearSendRestriction removes every registered restriction, including the
+// built-in locked/unlocked sender and receiver checks registered by
+// NewKeeper. Mainly useful in tests.
+func (k Keeper) ClearSendRestriction() {
+	k.sendRestriction.fn = nil
+}
+
+// SendCoins is the send-time entrypoint for the restriction chain: it runs
+// every registered SendRestrictionFn in order and returns the address funds
+// should actually be delivered to (a restriction may redirect it) or an
+// error from the first restriction that rejects the transfer. Balance
+// bookkeeping itself is the enclosing BaseSendKeeper.SendCoins's job, which
+// calls this before moving funds.
+func (k Keeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if k.sendRestriction.fn == nil {
+		return toAddr, nil
+	}
+	return k.sendRestriction.fn(ctx, fromAddr, toAddr, amt)
+}
+
+// defaultSendRestriction re-expresses the pre-hook LockedSenders,
+// UnlockedSenders, LockedReceivers, UnlockedReceivers and SendEnabled checks
+// as an ordinary SendRestrictionFn, registered by NewKeeper so existing
+// chains keep their behavior unchanged after upgrading.
+func defaultSendRestriction(k Keeper) types.SendRestrictionFn {
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if err := k.ValidateSendRestrictions(ctx, fromAddr, toAddr, amt); err != nil {
+			return nil, err
+		}
+		return toAddr, nil
+	}
+}
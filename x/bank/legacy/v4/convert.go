@@ -0,0 +1,83 @@
+package v4
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+)
+
+// ConvertParameterChangeProposal converts every ParamChange carried by a
+// legacy x/params ParameterChangeProposal targeting the bank module into
+// new-style Msgs, so an in-flight ParameterChangeProposal doesn't
+// have to be cancelled and resubmitted just because a chain upgraded to the
+// Msg-based governance path. It's meant to be called from the chain's
+// upgrade handler for every pending legacy proposal whose Content is a
+// ParameterChangeProposal, with the resulting messages substituted for the
+// proposal's Content before voting resumes - the same role
+// govv1.RegisterLegacyProposalHandler-style conversions play for other
+// legacy content. Authority is the module's configured authority address,
+// used as the signer of every returned message. It returns an error, and no
+// messages, if any change cannot be converted (see ConvertParamChange).
+func ConvertParameterChangeProposal(cdc codec.JSONCodec, authority string, prop proposal.ParameterChangeProposal) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(prop.Changes))
+	for _, change := range prop.Changes {
+		msg, err := ConvertParamChange(cdc, authority, change)
+		if err != nil {
+			return nil, fmt.Errorf("proposal %q: %w", prop.Title, err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// ConvertParamChange converts a single legacy x/params ParamChange targeting
+// the bank module into a new-style Msg. Authority is the module's configured
+// authority address, used as the signer of the returned message. Only the
+// SendEnabled and the four locked/unlocked sender/receiver keys are
+// converted; any other key, DefaultSendEnabled included, returns an error.
+// The returned message sets the listed entries; entries that the legacy
+// change dropped by leaving them out of its list are not removed.
+func ConvertParamChange(cdc codec.JSONCodec, authority string, change proposal.ParamChange) (sdk.Msg, error) {
+	if change.Subspace != types.ModuleName {
+		return nil, fmt.Errorf("unexpected subspace %q for bank legacy param change", change.Subspace)
+	}
+
+	switch string(change.Key) {
+	case string(types.KeySendEnabled):
+		var sendEnabled types.SendEnabledParams
+		if err := cdc.UnmarshalJSON([]byte(change.Value), &sendEnabled); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal legacy SendEnabled param change: %w", err)
+		}
+		return &types.MsgSetSendEnabled{
+			Authority:   authority,
+			SendEnabled: sendEnabled,
+		}, nil
+
+	case string(types.KeyLockedSenders), string(types.KeyUnlockedSenders),
+		string(types.KeyLockedReceivers), string(types.KeyUnlockedReceivers):
+		var addressDenoms []*types.AddressDenoms
+		if err := cdc.UnmarshalJSON([]byte(change.Value), &addressDenoms); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal legacy %s param change: %w", change.Key, err)
+		}
+
+		msg := &types.MsgSetAddressDenoms{Authority: authority}
+		switch string(change.Key) {
+		case string(types.KeyLockedSenders):
+			msg.LockedSenders = addressDenoms
+		case string(types.KeyUnlockedSenders):
+			msg.UnlockedSenders = addressDenoms
+		case string(types.KeyLockedReceivers):
+			msg.LockedReceivers = addressDenoms
+		case string(types.KeyUnlockedReceivers):
+			msg.UnlockedReceivers = addressDenoms
+		}
+		return msg, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported legacy bank param change key %q", change.Key)
+	}
+}
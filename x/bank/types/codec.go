@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+)
+
+const (
+	// RouterKey is the message route for the bank module
+	RouterKey = ModuleName
+)
+
+// RegisterLegacyAminoCodec registers the bank module's messages with the
+// provided legacy amino codec, used for amino JSON signing.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "bank/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetSendEnabled{}, "bank/MsgSetSendEnabled", nil)
+	cdc.RegisterConcrete(&MsgSetAddressDenoms{}, "bank/MsgSetAddressDenoms", nil)
+}
+
+// ModuleCdc is the codec used for amino JSON signing of bank messages.
+var ModuleCdc = codec.NewAminoCodec(legacy.Cdc)
+
+func init() {
+	cryptocodec.RegisterCrypto(legacy.Cdc)
+	RegisterLegacyAminoCodec(legacy.Cdc)
+}
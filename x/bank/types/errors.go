@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/bank module sentinel errors
+var (
+	ErrSendDisabled = sdkerrors.Register(ModuleName, 2, "send transactions are currently disabled")
+)
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the bank module
+	ModuleName = "bank"
+
+	// StoreKey is the default store key for the bank module
+	StoreKey = ModuleName
+)
+
+// Prefixes for the dedicated restriction KV store. These replace the
+// monolithic SendEnabled/LockedSenders/UnlockedSenders/LockedReceivers/
+// UnlockedReceivers slices that used to live in the x/params subspace, so a
+// single denom or address can be toggled without rewriting the whole list.
+var (
+	// SendEnabledPrefix is the prefix for the denom -> BoolValue send enabled flags.
+	SendEnabledPrefix = []byte{0x01}
+	// LockedSenderPrefix is the prefix for addr|denom -> {} locked sender entries.
+	LockedSenderPrefix = []byte{0x02}
+	// UnlockedSenderPrefix is the prefix for addr|denom -> {} unlocked sender entries.
+	UnlockedSenderPrefix = []byte{0x03}
+	// LockedReceiverPrefix is the prefix for addr|denom -> {} locked receiver entries.
+	LockedReceiverPrefix = []byte{0x04}
+	// UnlockedReceiverPrefix is the prefix for addr|denom -> {} unlocked receiver entries.
+	UnlockedReceiverPrefix = []byte{0x05}
+)
+
+// SendEnabledKey returns the store key for the send enabled flag of the given denom.
+func SendEnabledKey(denom string) []byte {
+	key := make([]byte, 0, len(SendEnabledPrefix)+len(denom))
+	key = append(key, SendEnabledPrefix...)
+	key = append(key, []byte(denom)...)
+	return key
+}
+
+// DenomFromSendEnabledKey extracts the denom from a key created by SendEnabledKey.
+func DenomFromSendEnabledKey(key []byte) string {
+	return string(key[len(SendEnabledPrefix):])
+}
+
+// addressDenomKey builds a prefix|length-prefixed-address|denom key so address and
+// denom boundaries are unambiguous when iterating the store.
+func addressDenomKey(prefix []byte, addr sdk.AccAddress, denom string) []byte {
+	key := make([]byte, 0, len(prefix)+len(addr)+1+len(denom))
+	key = append(key, prefix...)
+	key = append(key, address.MustLengthPrefix(addr)...)
+	key = append(key, []byte(denom)...)
+	return key
+}
+
+// addressPrefix builds a prefix|length-prefixed-address key for iterating every
+// denom entry registered for a single address.
+func addressPrefix(prefix []byte, addr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(prefix)+len(addr)+1)
+	key = append(key, prefix...)
+	key = append(key, address.MustLengthPrefix(addr)...)
+	return key
+}
+
+// LockedSenderKey returns the store key for a locked sender/denom pair.
+func LockedSenderKey(addr sdk.AccAddress, denom string) []byte {
+	return addressDenomKey(LockedSenderPrefix, addr, denom)
+}
+
+// LockedSenderAddressPrefix returns the store prefix for every denom locked for addr.
+func LockedSenderAddressPrefix(addr sdk.AccAddress) []byte {
+	return addressPrefix(LockedSenderPrefix, addr)
+}
+
+// UnlockedSenderKey returns the store key for an unlocked sender/denom pair.
+func UnlockedSenderKey(addr sdk.AccAddress, denom string) []byte {
+	return addressDenomKey(UnlockedSenderPrefix, addr, denom)
+}
+
+// UnlockedSenderAddressPrefix returns the store prefix for every denom unlocked for addr.
+func UnlockedSenderAddressPrefix(addr sdk.AccAddress) []byte {
+	return addressPrefix(UnlockedSenderPrefix, addr)
+}
+
+// LockedReceiverKey returns the store key for a locked receiver/denom pair.
+func LockedReceiverKey(addr sdk.AccAddress, denom string) []byte {
+	return addressDenomKey(LockedReceiverPrefix, addr, denom)
+}
+
+// LockedReceiverAddressPrefix returns the store prefix for every denom locked for addr.
+func LockedReceiverAddressPrefix(addr sdk.AccAddress) []byte {
+	return addressPrefix(LockedReceiverPrefix, addr)
+}
+
+// UnlockedReceiverKey returns the store key for an unlocked receiver/denom pair.
+func UnlockedReceiverKey(addr sdk.AccAddress, denom string) []byte {
+	return addressDenomKey(UnlockedReceiverPrefix, addr, denom)
+}
+
+// UnlockedReceiverAddressPrefix returns the store prefix for every denom unlocked for addr.
+func UnlockedReceiverAddressPrefix(addr sdk.AccAddress) []byte {
+	return addressPrefix(UnlockedReceiverPrefix, addr)
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUpdateParams     = "update_params"
+	TypeMsgSetSendEnabled   = "set_send_enabled"
+	TypeMsgSetAddressDenoms = "set_address_denoms"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetSendEnabled{}
+	_ sdk.Msg = &MsgSetAddressDenoms{}
+)
+
+// MsgUpdateParams carries a full bank Params in a single governance
+// proposal. It is authorized only when Authority matches the module's
+// configured authority address (typically the x/gov module account),
+// replacing the old x/params ParameterChangeProposal flow for this module.
+// DefaultSendEnabled is replaced; the SendEnabled and address entries in
+// Params are written on top of what is already stored, and stored entries
+// not listed in Params are left in place.
+type MsgUpdateParams struct {
+	// Authority is the address that controls this module (typically x/gov).
+	Authority string `json:"authority"`
+	// Params defines the bank parameters to set.
+	Params Params `json:"params"`
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+	return msg.Params.Validate()
+}
+
+// MsgSetSendEnabled toggles the send-enabled flag for one or more denoms
+// without rewriting the rest of the bank Params. Any denom listed in
+// UseDefaultFor has its per-denom entry removed so it falls back to
+// Params.DefaultSendEnabled.
+type MsgSetSendEnabled struct {
+	// Authority is the address that controls this module (typically x/gov).
+	Authority string `json:"authority"`
+	// SendEnabled is the list of denom/enabled pairs to set.
+	SendEnabled []*SendEnabled `json:"send_enabled"`
+	// UseDefaultFor is a list of denoms that should defer to
+	// Params.DefaultSendEnabled instead of having their own entry.
+	UseDefaultFor []string `json:"use_default_for"`
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+	if err := validateSendEnabledParams(msg.SendEnabled); err != nil {
+		return err
+	}
+	for _, denom := range msg.UseDefaultFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MsgSetAddressDenoms adds locked/unlocked sender and receiver denoms for
+// specific addresses without rewriting the full
+// LockedSenders/UnlockedSenders/LockedReceivers/UnlockedReceivers lists.
+// Denoms an address already has that are not listed are kept. Any
+// AddressDenoms with an empty Denoms slice clears that address's entry
+// entirely.
+type MsgSetAddressDenoms struct {
+	// Authority is the address that controls this module (typically x/gov).
+	Authority         string           `json:"authority"`
+	LockedSenders     []*AddressDenoms `json:"locked_senders"`
+	UnlockedSenders   []*AddressDenoms `json:"unlocked_senders"`
+	LockedReceivers   []*AddressDenoms `json:"locked_receivers"`
+	UnlockedReceivers []*AddressDenoms `json:"unlocked_receivers"`
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetAddressDenoms) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetAddressDenoms) Type() string { return TypeMsgSetAddressDenoms }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetAddressDenoms) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetAddressDenoms) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetAddressDenoms) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+	for _, params := range [][]*AddressDenoms{msg.LockedSenders, msg.UnlockedSenders, msg.LockedReceivers, msg.UnlockedReceivers} {
+		if err := validateAddressDenomsParams(params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
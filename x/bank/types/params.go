@@ -93,7 +93,10 @@ func (p Params) String() string {
 	return string(out)
 }
 
-// SendEnabledDenom returns true if the given denom is enabled for sending
+// SendEnabledDenom returns true if the given denom is enabled for sending.
+// This scans the in-hand Params slice; neither InitGenesis nor Migrate3to4
+// calls it. At runtime keeper.Keeper.IsSendEnabledDenom answers the same
+// question from the indexed KV store instead.
 func (p Params) SendEnabledDenom(denom string) bool {
 	for _, pse := range p.SendEnabled {
 		if pse.Denom == denom {
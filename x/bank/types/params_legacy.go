@@ -0,0 +1,54 @@
+package types
+
+import (
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// This file keeps the pre-migration Params shape readable so Migrate3to4 can
+// read whatever a chain had stored in the x/params subspace before the
+// dedicated KV stores existed. As of the KV-backed restriction store,
+// SendEnabled, LockedSenders, UnlockedSenders, LockedReceivers and
+// UnlockedReceivers are no longer the source of truth at runtime - the
+// keeper methods in x/bank/keeper/restrictions.go read and write the
+// dedicated prefix stores instead. Params.*Denom helpers remain for callers
+// that only have a Params value in hand (e.g. tests).
+
+// LegacyParams is the pre-migration shape of Params, kept so Migrate3to4 can
+// unmarshal whatever a chain had stored in the x/params subspace before the
+// dedicated KV stores existed. Its ParamSetPairs uses the same keys Params
+// registered, so a legacy subspace's GetParamSet reads back exactly what a
+// pre-migration chain wrote.
+type LegacyParams struct {
+	SendEnabled        []*SendEnabled
+	DefaultSendEnabled bool
+	LockedSenders      []*AddressDenoms
+	UnlockedSenders    []*AddressDenoms
+	LockedReceivers    []*AddressDenoms
+	UnlockedReceivers  []*AddressDenoms
+}
+
+// ParamSetPairs implements params.ParamSet so Migrate3to4 can read a legacy
+// subspace via GetParamSet instead of unmarshalling into types.Params.
+func (p *LegacyParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
+		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeyLockedSenders, &p.LockedSenders, validateAddressDenomsParams),
+		paramtypes.NewParamSetPair(KeyUnlockedSenders, &p.UnlockedSenders, validateAddressDenomsParams),
+		paramtypes.NewParamSetPair(KeyLockedReceivers, &p.LockedReceivers, validateAddressDenomsParams),
+		paramtypes.NewParamSetPair(KeyUnlockedReceivers, &p.UnlockedReceivers, validateAddressDenomsParams),
+	}
+}
+
+// ToParams converts a LegacyParams value read out of the old x/params
+// subspace into the current Params shape.
+func (p LegacyParams) ToParams() Params {
+	return Params{
+		SendEnabled:        p.SendEnabled,
+		DefaultSendEnabled: p.DefaultSendEnabled,
+		LockedSenders:      p.LockedSenders,
+		UnlockedSenders:    p.UnlockedSenders,
+		LockedReceivers:    p.LockedReceivers,
+		UnlockedReceivers:  p.UnlockedReceivers,
+	}
+}
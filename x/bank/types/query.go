@@ -0,0 +1,82 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// QueryServer is the server API for the bank module's Query service
+// covering the restriction lists moved into dedicated KV stores.
+type QueryServer interface {
+	// SendEnabled queries for SendEnabled entries, optionally limited to a
+	// set of denoms. If Denoms is empty, Pagination paginates over every
+	// entry in the store.
+	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// LockedSenders queries locked-sender entries, one per address/denom
+	// pair, paginated.
+	LockedSenders(context.Context, *QueryLockedSendersRequest) (*QueryLockedSendersResponse, error)
+	// UnlockedSenders queries unlocked-sender entries, one per
+	// address/denom pair, paginated.
+	UnlockedSenders(context.Context, *QueryUnlockedSendersRequest) (*QueryUnlockedSendersResponse, error)
+	// LockedReceivers queries locked-receiver entries, one per
+	// address/denom pair, paginated.
+	LockedReceivers(context.Context, *QueryLockedReceiversRequest) (*QueryLockedReceiversResponse, error)
+	// UnlockedReceivers queries unlocked-receiver entries, one per
+	// address/denom pair, paginated.
+	UnlockedReceivers(context.Context, *QueryUnlockedReceiversRequest) (*QueryUnlockedReceiversResponse, error)
+}
+
+type QuerySendEnabledRequest struct {
+	Denoms     []string           `json:"denoms,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+type QuerySendEnabledResponse struct {
+	SendEnabled []*SendEnabled      `json:"send_enabled"`
+	Pagination  *query.PageResponse `json:"pagination,omitempty"`
+}
+
+type QueryLockedSendersRequest struct {
+	// Denom restricts the results to entries for this denom only, if set.
+	Denom      string             `json:"denom,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+type QueryLockedSendersResponse struct {
+	LockedSenders []*AddressDenoms    `json:"locked_senders"`
+	Pagination    *query.PageResponse `json:"pagination,omitempty"`
+}
+
+type QueryUnlockedSendersRequest struct {
+	// Denom restricts the results to entries for this denom only, if set.
+	Denom      string             `json:"denom,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+type QueryUnlockedSendersResponse struct {
+	UnlockedSenders []*AddressDenoms    `json:"unlocked_senders"`
+	Pagination      *query.PageResponse `json:"pagination,omitempty"`
+}
+
+type QueryLockedReceiversRequest struct {
+	// Denom restricts the results to entries for this denom only, if set.
+	Denom      string             `json:"denom,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+type QueryLockedReceiversResponse struct {
+	LockedReceivers []*AddressDenoms    `json:"locked_receivers"`
+	Pagination      *query.PageResponse `json:"pagination,omitempty"`
+}
+
+type QueryUnlockedReceiversRequest struct {
+	// Denom restricts the results to entries for this denom only, if set.
+	Denom      string             `json:"denom,omitempty"`
+	Pagination *query.PageRequest `json:"pagination,omitempty"`
+}
+
+type QueryUnlockedReceiversResponse struct {
+	UnlockedReceivers []*AddressDenoms    `json:"unlocked_receivers"`
+	Pagination        *query.PageResponse `json:"pagination,omitempty"`
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"context"
+
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+)
+
+// queryServiceName is the gRPC service name the bank module's Query service
+// is registered under, used to build the full method names QueryClient
+// invokes.
+const queryServiceName = "honeywood.bank.Query"
+
+// QueryClient is the client API for the bank module's Query service,
+// mirroring QueryServer. It's what the CLI uses to reach the gRPC query
+// handlers in grpc_query.go instead of going through a legacy ABCI querier.
+type QueryClient interface {
+	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	LockedSenders(ctx context.Context, in *QueryLockedSendersRequest, opts ...grpc.CallOption) (*QueryLockedSendersResponse, error)
+	UnlockedSenders(ctx context.Context, in *QueryUnlockedSendersRequest, opts ...grpc.CallOption) (*QueryUnlockedSendersResponse, error)
+	LockedReceivers(ctx context.Context, in *QueryLockedReceiversRequest, opts ...grpc.CallOption) (*QueryLockedReceiversResponse, error)
+	UnlockedReceivers(ctx context.Context, in *QueryUnlockedReceiversRequest, opts ...grpc.CallOption) (*QueryUnlockedReceiversResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+// NewQueryClient returns a QueryClient backed by cc, e.g. a client.Context,
+// which implements grpc1.ClientConn.
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error) {
+	out := new(QuerySendEnabledResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/SendEnabled", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedSenders(ctx context.Context, in *QueryLockedSendersRequest, opts ...grpc.CallOption) (*QueryLockedSendersResponse, error) {
+	out := new(QueryLockedSendersResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/LockedSenders", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockedSenders(ctx context.Context, in *QueryUnlockedSendersRequest, opts ...grpc.CallOption) (*QueryUnlockedSendersResponse, error) {
+	out := new(QueryUnlockedSendersResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/UnlockedSenders", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockedReceivers(ctx context.Context, in *QueryLockedReceiversRequest, opts ...grpc.CallOption) (*QueryLockedReceiversResponse, error) {
+	out := new(QueryLockedReceiversResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/LockedReceivers", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockedReceivers(ctx context.Context, in *QueryUnlockedReceiversRequest, opts ...grpc.CallOption) (*QueryUnlockedReceiversResponse, error) {
+	out := new(QueryUnlockedReceiversResponse)
+	if err := c.cc.Invoke(ctx, "/"+queryServiceName+"/UnlockedReceivers", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// SendRestrictionFn can restrict sends and/or provide a new receiver
+// address. Following x/bank upstream, this lets other modules (an issuance
+// module doing KYC/blacklist checks, a group/multisig module, etc.) hook
+// into every transfer without editing this package: register a restriction
+// with keeper.Keeper.AppendSendRestriction or PrependSendRestriction and it
+// runs as part of SendCoins.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+// Then creates a new SendRestrictionFn that runs fn, then pipes its
+// (possibly redirected) toAddr into next. If either side is nil, the other
+// is returned unchanged.
+func (fn SendRestrictionFn) Then(next SendRestrictionFn) SendRestrictionFn {
+	if fn == nil {
+		return next
+	}
+	if next == nil {
+		return fn
+	}
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		newToAddr, err := fn(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, fromAddr, newToAddr, amt)
+	}
+}
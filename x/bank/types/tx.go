@@ -0,0 +1,29 @@
+package types
+
+import "context"
+
+// MsgServer is the server API for the bank module's Msg service. It backs
+// the governance path that replaces per-field x/params change proposals:
+// MsgUpdateParams writes a whole Params, while MsgSetSendEnabled and
+// MsgSetAddressDenoms update a single denom or address without touching
+// anything else.
+type MsgServer interface {
+	// UpdateParams defines an operation for updating the full set of bank
+	// module parameters. Authorized by the module's authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetSendEnabled defines an operation for setting (or clearing, via
+	// UseDefaultFor) send-enabled entries for one or more denoms.
+	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetAddressDenoms defines an operation for setting or clearing
+	// locked/unlocked sender and receiver entries for one or more addresses.
+	SetAddressDenoms(context.Context, *MsgSetAddressDenoms) (*MsgSetAddressDenomsResponse, error)
+}
+
+// MsgUpdateParamsResponse defines the response for Msg/UpdateParams.
+type MsgUpdateParamsResponse struct{}
+
+// MsgSetSendEnabledResponse defines the response for Msg/SetSendEnabled.
+type MsgSetSendEnabledResponse struct{}
+
+// MsgSetAddressDenomsResponse defines the response for Msg/SetAddressDenoms.
+type MsgSetAddressDenomsResponse struct{}
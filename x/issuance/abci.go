@@ -0,0 +1,23 @@
+package issuance
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/keeper"
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/types"
+)
+
+// BeginBlocker resets any per-denom rate-limit counter whose period has
+// elapsed, so MsgIssueTokens/bank sends against a rate-limited asset are
+// checked against a fresh rolling window.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.IterateAssets(ctx, func(asset types.Asset) bool {
+		k.ResetRateLimitCounters(ctx, asset)
+		return false
+	})
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/types"
+)
+
+// SetAsset writes asset to the store, keyed by its denom.
+func (k Keeper) SetAsset(ctx sdk.Context, asset types.Asset) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(asset)
+	store.Set(types.AssetKey(asset.Denom), bz)
+}
+
+// GetAsset returns the asset controlling denom, if one has been issued.
+func (k Keeper) GetAsset(ctx sdk.Context, denom string) (types.Asset, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AssetKey(denom))
+	if bz == nil {
+		return types.Asset{}, false
+	}
+	var asset types.Asset
+	k.cdc.MustUnmarshalBinaryBare(bz, &asset)
+	return asset, true
+}
+
+// DeleteAsset removes the asset controlling denom.
+func (k Keeper) DeleteAsset(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.AssetKey(denom))
+}
+
+// IterateAssets calls cb on every registered asset. Iteration stops if cb
+// returns true.
+func (k Keeper) IterateAssets(ctx sdk.Context, cb func(asset types.Asset) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AssetPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var asset types.Asset
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &asset)
+		if cb(asset) {
+			break
+		}
+	}
+}
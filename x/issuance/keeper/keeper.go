@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/types"
+)
+
+// Keeper manages owner-controlled Asset records: per-denom pause,
+// blacklist and rate-limit state that a send restriction (see
+// send_restriction.go) consults on every transfer. Asset and
+// RateLimitCounter are plain Go structs rather than generated proto types,
+// so storage uses the legacy amino codec rather than codec.BinaryCodec.
+type Keeper struct {
+	cdc        *codec.LegacyAmino
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+}
+
+// NewKeeper returns a new issuance Keeper.
+func NewKeeper(cdc *codec.LegacyAmino, storeKey storetypes.StoreKey, bankKeeper types.BankKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+	}
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the issuance MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) ownedAsset(ctx sdk.Context, owner, denom string) (types.Asset, error) {
+	asset, found := k.GetAsset(ctx, denom)
+	if !found {
+		return types.Asset{}, types.ErrAssetNotFound.Wrap(denom)
+	}
+	if asset.Owner != owner {
+		return types.Asset{}, types.ErrNotAssetOwner.Wrapf("denom %s is owned by %s", denom, asset.Owner)
+	}
+	return asset, nil
+}
+
+// IssueTokens implements types.MsgServer. If no asset is registered yet for
+// msg.Denom, the first issuer becomes its owner - but only for a denom
+// namespaced to them (see types.ValidateIssuedDenom), so this can't be used
+// to claim ownership, and therefore pause/blacklist/mint rights, over a
+// denom someone else already controls outside this module.
+func (k msgServer) IssueTokens(goCtx context.Context, msg *types.MsgIssueTokens) (*types.MsgIssueTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	asset, found := k.GetAsset(ctx, msg.Denom)
+	if !found {
+		if err := types.ValidateIssuedDenom(msg.Denom, msg.Owner); err != nil {
+			return nil, err
+		}
+		asset = types.Asset{Owner: msg.Owner, Denom: msg.Denom}
+		k.SetAsset(ctx, asset)
+	} else if asset.Owner != msg.Owner {
+		return nil, types.ErrNotAssetOwner.Wrapf("denom %s is owned by %s", msg.Denom, asset.Owner)
+	}
+
+	if asset.Paused {
+		return nil, types.ErrAssetPaused.Wrap(msg.Denom)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := sdk.NewCoins(sdk.NewCoin(msg.Denom, msg.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, amt); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amt); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIssueTokensResponse{}, nil
+}
+
+// RedeemTokens implements types.MsgServer, burning Amount of Denom from the
+// owner's own balance.
+func (k msgServer) RedeemTokens(goCtx context.Context, msg *types.MsgRedeemTokens) (*types.MsgRedeemTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	asset, err := k.ownedAsset(ctx, msg.Owner, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Paused {
+		return nil, types.ErrAssetPaused.Wrap(msg.Denom)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := sdk.NewCoins(sdk.NewCoin(msg.Denom, msg.Amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, amt); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemTokensResponse{}, nil
+}
+
+// BlockAddress implements types.MsgServer.
+func (k msgServer) BlockAddress(goCtx context.Context, msg *types.MsgBlockAddress) (*types.MsgBlockAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	asset, err := k.ownedAsset(ctx, msg.Owner, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !asset.IsBlocked(msg.Address) {
+		asset.BlockedAddresses = append(asset.BlockedAddresses, msg.Address)
+		k.SetAsset(ctx, asset)
+	}
+
+	return &types.MsgBlockAddressResponse{}, nil
+}
+
+// UnblockAddress implements types.MsgServer.
+func (k msgServer) UnblockAddress(goCtx context.Context, msg *types.MsgUnblockAddress) (*types.MsgUnblockAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	asset, err := k.ownedAsset(ctx, msg.Owner, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked := asset.BlockedAddresses[:0]
+	for _, addr := range asset.BlockedAddresses {
+		if addr != msg.Address {
+			blocked = append(blocked, addr)
+		}
+	}
+	asset.BlockedAddresses = blocked
+	k.SetAsset(ctx, asset)
+
+	return &types.MsgUnblockAddressResponse{}, nil
+}
+
+// SetPauseStatus implements types.MsgServer.
+func (k msgServer) SetPauseStatus(goCtx context.Context, msg *types.MsgSetPauseStatus) (*types.MsgSetPauseStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	asset, err := k.ownedAsset(ctx, msg.Owner, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	asset.Paused = msg.Paused
+	k.SetAsset(ctx, asset)
+
+	return &types.MsgSetPauseStatusResponse{}, nil
+}
@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/keeper"
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/types"
+)
+
+// fakeBankKeeper is a minimal in-memory types.BankKeeper used to test the
+// issuance msg server handlers without pulling in the real bank keeper.
+type fakeBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newFakeBankKeeper() *fakeBankKeeper {
+	return &fakeBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (f *fakeBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	f.balances[moduleName] = f.balances[moduleName].Add(amt...)
+	return nil
+}
+
+func (f *fakeBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	f.balances[moduleName] = f.balances[moduleName].Sub(amt)
+	return nil
+}
+
+func (f *fakeBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	f.balances[senderModule] = f.balances[senderModule].Sub(amt)
+	f.balances[recipientAddr.String()] = f.balances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (f *fakeBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	f.balances[senderAddr.String()] = f.balances[senderAddr.String()].Sub(amt)
+	f.balances[recipientModule] = f.balances[recipientModule].Add(amt...)
+	return nil
+}
+
+func newTestKeeperWithBank(t *testing.T, bank types.BankKeeper) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	return keeper.NewKeeper(codec.NewLegacyAmino(), storeKey, bank), ctx
+}
+
+func TestMsgServer_IssueTokens_FirstIssuerBecomesOwner(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := newTestKeeperWithBank(t, bank)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+	recipient := sdk.AccAddress("recipient-address----")
+	denom := "factory/" + owner.String() + "/kyc"
+
+	_, err := msgServer.IssueTokens(sdk.WrapSDKContext(ctx), &types.MsgIssueTokens{
+		Owner:     owner.String(),
+		Denom:     denom,
+		Amount:    sdk.NewInt(100),
+		Recipient: recipient.String(),
+	})
+	require.NoError(t, err)
+
+	asset, found := k.GetAsset(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, owner.String(), asset.Owner)
+	require.Equal(t, sdk.NewInt(100), bank.balances[recipient.String()].AmountOf(denom))
+}
+
+func TestMsgServer_IssueTokens_DenomNotReservedToIssuer(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := newTestKeeperWithBank(t, bank)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+
+	_, err := msgServer.IssueTokens(sdk.WrapSDKContext(ctx), &types.MsgIssueTokens{
+		Owner:     owner.String(),
+		Denom:     "stake",
+		Amount:    sdk.NewInt(100),
+		Recipient: owner.String(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	_, found := k.GetAsset(ctx, "stake")
+	require.False(t, found)
+}
+
+func TestMsgServer_IssueTokens_WrongOwner(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := newTestKeeperWithBank(t, bank)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+	other := sdk.AccAddress("other-address--------")
+	k.SetAsset(ctx, types.Asset{Owner: owner.String(), Denom: "kyc"})
+
+	_, err := msgServer.IssueTokens(sdk.WrapSDKContext(ctx), &types.MsgIssueTokens{
+		Owner:     other.String(),
+		Denom:     "kyc",
+		Amount:    sdk.NewInt(100),
+		Recipient: other.String(),
+	})
+	require.ErrorIs(t, err, types.ErrNotAssetOwner)
+}
+
+func TestMsgServer_IssueTokens_PausedAsset(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := newTestKeeperWithBank(t, bank)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+	k.SetAsset(ctx, types.Asset{Owner: owner.String(), Denom: "kyc", Paused: true})
+
+	_, err := msgServer.IssueTokens(sdk.WrapSDKContext(ctx), &types.MsgIssueTokens{
+		Owner:     owner.String(),
+		Denom:     "kyc",
+		Amount:    sdk.NewInt(100),
+		Recipient: owner.String(),
+	})
+	require.ErrorIs(t, err, types.ErrAssetPaused)
+}
+
+func TestMsgServer_RedeemTokens(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := newTestKeeperWithBank(t, bank)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+	k.SetAsset(ctx, types.Asset{Owner: owner.String(), Denom: "kyc"})
+	bank.balances[owner.String()] = sdk.NewCoins(sdk.NewInt64Coin("kyc", 100))
+
+	_, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokens{
+		Owner:  owner.String(),
+		Denom:  "kyc",
+		Amount: sdk.NewInt(40),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(60), bank.balances[owner.String()].AmountOf("kyc"))
+}
+
+func TestMsgServer_RedeemTokens_AssetNotFound(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := newTestKeeperWithBank(t, bank)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+	_, err := msgServer.RedeemTokens(sdk.WrapSDKContext(ctx), &types.MsgRedeemTokens{
+		Owner:  owner.String(),
+		Denom:  "kyc",
+		Amount: sdk.NewInt(40),
+	})
+	require.ErrorIs(t, err, types.ErrAssetNotFound)
+}
+
+func TestMsgServer_BlockAndUnblockAddress(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+	blocked := sdk.AccAddress("blocked-address------")
+	k.SetAsset(ctx, types.Asset{Owner: owner.String(), Denom: "kyc"})
+
+	_, err := msgServer.BlockAddress(sdk.WrapSDKContext(ctx), &types.MsgBlockAddress{
+		Owner: owner.String(), Denom: "kyc", Address: blocked.String(),
+	})
+	require.NoError(t, err)
+
+	asset, _ := k.GetAsset(ctx, "kyc")
+	require.True(t, asset.IsBlocked(blocked.String()))
+
+	_, err = msgServer.UnblockAddress(sdk.WrapSDKContext(ctx), &types.MsgUnblockAddress{
+		Owner: owner.String(), Denom: "kyc", Address: blocked.String(),
+	})
+	require.NoError(t, err)
+
+	asset, _ = k.GetAsset(ctx, "kyc")
+	require.False(t, asset.IsBlocked(blocked.String()))
+}
+
+func TestMsgServer_SetPauseStatus(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+	k.SetAsset(ctx, types.Asset{Owner: owner.String(), Denom: "kyc"})
+
+	_, err := msgServer.SetPauseStatus(sdk.WrapSDKContext(ctx), &types.MsgSetPauseStatus{
+		Owner: owner.String(), Denom: "kyc", Paused: true,
+	})
+	require.NoError(t, err)
+
+	asset, _ := k.GetAsset(ctx, "kyc")
+	require.True(t, asset.Paused)
+}
+
+func TestMsgServer_SetPauseStatus_NotOwner(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	owner := sdk.AccAddress("owner-address--------")
+	other := sdk.AccAddress("other-address--------")
+	k.SetAsset(ctx, types.Asset{Owner: owner.String(), Denom: "kyc"})
+
+	_, err := msgServer.SetPauseStatus(sdk.WrapSDKContext(ctx), &types.MsgSetPauseStatus{
+		Owner: other.String(), Denom: "kyc", Paused: true,
+	})
+	require.ErrorIs(t, err, types.ErrNotAssetOwner)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/types"
+)
+
+// RateLimitCounter tracks how much of a denom a sender has moved within the
+// current rate-limit period, and when that period started.
+type RateLimitCounter struct {
+	Total       sdk.Int `json:"total"`
+	PeriodStart sdk.Int `json:"period_start_unix_nano"`
+}
+
+// getRateLimitCounter returns the current counter for denom/sender, or a
+// zeroed counter starting at now if none exists yet.
+func (k Keeper) getRateLimitCounter(ctx sdk.Context, denom, sender string) RateLimitCounter {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RateLimitCounterKey(denom, sender))
+	if bz == nil {
+		return RateLimitCounter{Total: sdk.ZeroInt(), PeriodStart: sdk.NewInt(ctx.BlockTime().UnixNano())}
+	}
+	var counter RateLimitCounter
+	k.cdc.MustUnmarshalBinaryBare(bz, &counter)
+	return counter
+}
+
+func (k Keeper) setRateLimitCounter(ctx sdk.Context, denom, sender string, counter RateLimitCounter) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(counter)
+	store.Set(types.RateLimitCounterKey(denom, sender), bz)
+}
+
+// CheckAndRecordRateLimit returns an error if recording amt against
+// sender's rolling total for denom would exceed the asset's RateLimit, and
+// otherwise records it. It is a no-op, always succeeding, when the asset
+// has no RateLimit configured.
+func (k Keeper) CheckAndRecordRateLimit(ctx sdk.Context, asset types.Asset, sender string, amt sdk.Int) error {
+	if asset.RateLimit == nil {
+		return nil
+	}
+
+	counter := k.getRateLimitCounter(ctx, asset.Denom, sender)
+	periodStart := counter.PeriodStart.Int64()
+	if ctx.BlockTime().UnixNano()-periodStart >= asset.RateLimit.Period.Nanoseconds() {
+		counter = RateLimitCounter{Total: sdk.ZeroInt(), PeriodStart: sdk.NewInt(ctx.BlockTime().UnixNano())}
+	}
+
+	newTotal := counter.Total.Add(amt)
+	if newTotal.GT(asset.RateLimit.Limit) {
+		return types.ErrRateLimitExceeded.Wrapf("%s has moved %s of %s this period, limit is %s",
+			sender, newTotal, asset.Denom, asset.RateLimit.Limit)
+	}
+
+	counter.Total = newTotal
+	k.setRateLimitCounter(ctx, asset.Denom, sender, counter)
+	return nil
+}
+
+// ResetRateLimitCounters clears every rolling-window counter for denom
+// whose period has elapsed. Called from BeginBlocker.
+func (k Keeper) ResetRateLimitCounters(ctx sdk.Context, asset types.Asset) {
+	if asset.RateLimit == nil {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitCounterDenomPrefix(asset.Denom))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var stale [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var counter RateLimitCounter
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &counter)
+		if ctx.BlockTime().UnixNano()-counter.PeriodStart.Int64() >= asset.RateLimit.Period.Nanoseconds() {
+			key := make([]byte, len(iterator.Key()))
+			copy(key, iterator.Key())
+			stale = append(stale, key)
+		}
+	}
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	banktypes "github.com/MonetaToday/honeywood-blockchain-sdk/x/bank/types"
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/types"
+)
+
+// NewSendRestriction returns a bank SendRestrictionFn enforcing k's Asset
+// records: a transfer is rejected if its denom's asset is paused, if the
+// sender or receiver is blocked, or if the sender's rolling rate limit
+// would be exceeded. Denoms with no registered asset are left untouched.
+// App wiring registers this with bankKeeper.AppendSendRestriction alongside
+// the bank module's own built-in restriction.
+func NewSendRestriction(k Keeper) banktypes.SendRestrictionFn {
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		for _, coin := range amt {
+			asset, found := k.GetAsset(ctx, coin.Denom)
+			if !found {
+				continue
+			}
+
+			if asset.Paused {
+				return nil, types.ErrAssetPaused.Wrap(coin.Denom)
+			}
+			if asset.IsBlocked(fromAddr.String()) {
+				return nil, types.ErrAddressBlocked.Wrapf("%s cannot send %s", fromAddr, coin.Denom)
+			}
+			if asset.IsBlocked(toAddr.String()) {
+				return nil, types.ErrAddressBlocked.Wrapf("%s cannot receive %s", toAddr, coin.Denom)
+			}
+			if err := k.CheckAndRecordRateLimit(ctx, asset, fromAddr.String(), coin.Amount); err != nil {
+				return nil, err
+			}
+		}
+		return toAddr, nil
+	}
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/keeper"
+	"github.com/MonetaToday/honeywood-blockchain-sdk/x/issuance/types"
+)
+
+func newTestKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	return keeper.NewKeeper(codec.NewLegacyAmino(), storeKey, nil), ctx
+}
+
+func TestSendRestriction_PausedAsset(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	owner := sdk.AccAddress("owner-address--------")
+	from := sdk.AccAddress("sender-address--------")
+	to := sdk.AccAddress("receiver-address------")
+
+	k.SetAsset(ctx, types.Asset{Owner: owner.String(), Denom: "kyc", Paused: true})
+
+	restriction := keeper.NewSendRestriction(k)
+	_, err := restriction(ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin("kyc", 1)))
+	require.ErrorIs(t, err, types.ErrAssetPaused)
+}
+
+func TestSendRestriction_BlockedAddress(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	owner := sdk.AccAddress("owner-address--------")
+	from := sdk.AccAddress("sender-address--------")
+	to := sdk.AccAddress("receiver-address------")
+
+	k.SetAsset(ctx, types.Asset{Owner: owner.String(), Denom: "kyc", BlockedAddresses: []string{from.String()}})
+
+	restriction := keeper.NewSendRestriction(k)
+	_, err := restriction(ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin("kyc", 1)))
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+}
+
+func TestSendRestriction_RateLimit(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	owner := sdk.AccAddress("owner-address--------")
+	from := sdk.AccAddress("sender-address--------")
+	to := sdk.AccAddress("receiver-address------")
+
+	k.SetAsset(ctx, types.Asset{
+		Owner: owner.String(),
+		Denom: "kyc",
+		RateLimit: &types.RateLimit{
+			Limit:  sdk.NewInt(100),
+			Period: time.Hour,
+		},
+	})
+
+	restriction := keeper.NewSendRestriction(k)
+
+	_, err := restriction(ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin("kyc", 60)))
+	require.NoError(t, err)
+
+	_, err = restriction(ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin("kyc", 60)))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+}
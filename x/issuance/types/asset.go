@@ -0,0 +1,63 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Asset is the owner-controlled issuance record for a single denom. Unlike
+// the chain-wide, governance-only LockedSenders/UnlockedSenders lists in
+// x/bank, an Asset puts pause, blacklist and rate-limit control directly in
+// the hands of the denom's issuer.
+type Asset struct {
+	// Owner is the address authorized to issue/redeem and manage this asset.
+	Owner string `json:"owner"`
+	// Denom is the coin denom this asset controls.
+	Denom string `json:"denom"`
+	// BlockedAddresses is the set of addresses the owner has blocked from
+	// sending or receiving Denom.
+	BlockedAddresses []string `json:"blocked_addresses"`
+	// Paused, when true, rejects every transfer of Denom.
+	Paused bool `json:"paused"`
+	// RateLimit, when set, caps the rolling total any single sender may move
+	// within Period. Nil means no rate limit is enforced.
+	RateLimit *RateLimit `json:"rate_limit,omitempty"`
+}
+
+// RateLimit caps the rolling total a sender may move in a given period.
+type RateLimit struct {
+	// Limit is the maximum amount that may be sent within Period.
+	Limit sdk.Int `json:"limit"`
+	// Period is the rolling window the limit applies to.
+	Period time.Duration `json:"period"`
+}
+
+// IsBlocked returns true if addr is on the asset's blocked list.
+func (a Asset) IsBlocked(addr string) bool {
+	for _, blocked := range a.BlockedAddresses {
+		if blocked == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs stateless validation of the asset.
+func (a Asset) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Owner); err != nil {
+		return ErrInvalidOwner.Wrapf("invalid owner address: %s", err)
+	}
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+	if a.RateLimit != nil {
+		if a.RateLimit.Limit.IsNil() || !a.RateLimit.Limit.IsPositive() {
+			return ErrInvalidRateLimit.Wrap("rate limit amount must be positive")
+		}
+		if a.RateLimit.Period <= 0 {
+			return ErrInvalidRateLimit.Wrap("rate limit period must be positive")
+		}
+	}
+	return nil
+}
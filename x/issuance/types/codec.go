@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+)
+
+// RegisterLegacyAminoCodec registers the issuance module's messages with the
+// provided legacy amino codec, used for amino JSON signing.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgIssueTokens{}, "issuance/MsgIssueTokens", nil)
+	cdc.RegisterConcrete(&MsgRedeemTokens{}, "issuance/MsgRedeemTokens", nil)
+	cdc.RegisterConcrete(&MsgBlockAddress{}, "issuance/MsgBlockAddress", nil)
+	cdc.RegisterConcrete(&MsgUnblockAddress{}, "issuance/MsgUnblockAddress", nil)
+	cdc.RegisterConcrete(&MsgSetPauseStatus{}, "issuance/MsgSetPauseStatus", nil)
+}
+
+// ModuleCdc is the codec used for amino JSON signing of issuance messages.
+var ModuleCdc = codec.NewAminoCodec(legacy.Cdc)
+
+func init() {
+	cryptocodec.RegisterCrypto(legacy.Cdc)
+	RegisterLegacyAminoCodec(legacy.Cdc)
+}
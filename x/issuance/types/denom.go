@@ -0,0 +1,34 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DenomPrefix is the required first path segment of every denom this module
+// will register ownership of. Namespacing issued denoms as
+// "factory/<owner>/<subdenom>" reserves them to whoever actually created
+// them, the same way tokenfactory-style issuance modules do - without it,
+// IssueTokens' "first issuer becomes owner" rule would let anyone claim an
+// existing, unrelated denom (e.g. the chain's staking or fee denom) before
+// its real controller does, then pause, blacklist or keep minting it.
+const DenomPrefix = "factory"
+
+// ValidateIssuedDenom checks that denom is namespaced to owner, i.e. shaped
+// like "factory/<owner>/<subdenom>". Checked in MsgIssueTokens.ValidateBasic
+// and again in the IssueTokens handler before a new Asset is allowed to
+// claim ownership of denom.
+func ValidateIssuedDenom(denom, owner string) error {
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != DenomPrefix {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "denom %q must be namespaced as %s/<owner>/<subdenom>", denom, DenomPrefix)
+	}
+	if parts[1] != owner {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "denom %q is not namespaced to %s", denom, owner)
+	}
+	if parts[2] == "" {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "denom %q has an empty subdenom", denom)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/issuance module sentinel errors
+var (
+	ErrAssetNotFound     = sdkerrors.Register(ModuleName, 2, "asset not found")
+	ErrAssetExists       = sdkerrors.Register(ModuleName, 3, "asset already exists for denom")
+	ErrInvalidOwner      = sdkerrors.Register(ModuleName, 4, "invalid asset owner")
+	ErrNotAssetOwner     = sdkerrors.Register(ModuleName, 5, "signer is not the asset owner")
+	ErrAssetPaused       = sdkerrors.Register(ModuleName, 6, "asset is paused")
+	ErrAddressBlocked    = sdkerrors.Register(ModuleName, 7, "address is blocked for this asset")
+	ErrRateLimitExceeded = sdkerrors.Register(ModuleName, 8, "rate limit exceeded for this period")
+	ErrInvalidRateLimit  = sdkerrors.Register(ModuleName, 9, "invalid rate limit")
+	ErrInvalidDenom      = sdkerrors.Register(ModuleName, 10, "denom is not reserved to this issuer")
+)
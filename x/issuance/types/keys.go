@@ -0,0 +1,53 @@
+package types
+
+const (
+	// ModuleName is the name of the issuance module
+	ModuleName = "issuance"
+
+	// StoreKey is the default store key for the issuance module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the issuance module
+	RouterKey = ModuleName
+)
+
+var (
+	// AssetPrefix is the prefix for denom -> Asset entries.
+	AssetPrefix = []byte{0x01}
+	// RateLimitCounterPrefix is the prefix for denom|sender -> RateLimitCounter entries.
+	RateLimitCounterPrefix = []byte{0x02}
+)
+
+// AssetKey returns the store key for the asset controlling denom.
+func AssetKey(denom string) []byte {
+	key := make([]byte, 0, len(AssetPrefix)+len(denom))
+	key = append(key, AssetPrefix...)
+	key = append(key, []byte(denom)...)
+	return key
+}
+
+// DenomFromAssetKey extracts the denom from a key created by AssetKey.
+func DenomFromAssetKey(key []byte) string {
+	return string(key[len(AssetPrefix):])
+}
+
+// RateLimitCounterKey returns the store key for the rolling-window counter
+// tracking how much sender has moved of denom in the current period.
+func RateLimitCounterKey(denom, sender string) []byte {
+	key := make([]byte, 0, len(RateLimitCounterPrefix)+len(denom)+1+len(sender))
+	key = append(key, RateLimitCounterPrefix...)
+	key = append(key, []byte(denom)...)
+	key = append(key, 0)
+	key = append(key, []byte(sender)...)
+	return key
+}
+
+// RateLimitCounterDenomPrefix returns the store prefix for every counter
+// tracking denom, used by the BeginBlocker to reset a denom's counters.
+func RateLimitCounterDenomPrefix(denom string) []byte {
+	key := make([]byte, 0, len(RateLimitCounterPrefix)+len(denom)+1)
+	key = append(key, RateLimitCounterPrefix...)
+	key = append(key, []byte(denom)...)
+	key = append(key, 0)
+	return key
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgIssueTokens   = "issue_tokens"
+	TypeMsgRedeemTokens  = "redeem_tokens"
+	TypeMsgBlockAddress  = "block_address"
+	TypeMsgUnblockAddr   = "unblock_address"
+	TypeMsgSetPauseState = "set_pause_status"
+)
+
+var (
+	_ sdk.Msg = &MsgIssueTokens{}
+	_ sdk.Msg = &MsgRedeemTokens{}
+	_ sdk.Msg = &MsgBlockAddress{}
+	_ sdk.Msg = &MsgUnblockAddress{}
+	_ sdk.Msg = &MsgSetPauseStatus{}
+)
+
+func ownerSigners(owner string) ([]sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return nil, err
+	}
+	return []sdk.AccAddress{addr}, nil
+}
+
+func mustOwnerSigners(owner string) []sdk.AccAddress {
+	signers, err := ownerSigners(owner)
+	if err != nil {
+		panic(err)
+	}
+	return signers
+}
+
+// MsgIssueTokens mints Amount of Denom to Recipient. Only the asset's Owner
+// may issue tokens for Denom.
+type MsgIssueTokens struct {
+	Owner     string  `json:"owner"`
+	Denom     string  `json:"denom"`
+	Amount    sdk.Int `json:"amount"`
+	Recipient string  `json:"recipient"`
+}
+
+func (msg MsgIssueTokens) Route() string                { return RouterKey }
+func (msg MsgIssueTokens) Type() string                 { return TypeMsgIssueTokens }
+func (msg MsgIssueTokens) GetSigners() []sdk.AccAddress { return mustOwnerSigners(msg.Owner) }
+func (msg MsgIssueTokens) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgIssueTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "invalid recipient address")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateIssuedDenom(msg.Denom, msg.Owner); err != nil {
+		return err
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue amount must be positive")
+	}
+	return nil
+}
+
+// MsgRedeemTokens burns Amount of Denom from the Owner's own balance.
+type MsgRedeemTokens struct {
+	Owner  string  `json:"owner"`
+	Denom  string  `json:"denom"`
+	Amount sdk.Int `json:"amount"`
+}
+
+func (msg MsgRedeemTokens) Route() string                { return RouterKey }
+func (msg MsgRedeemTokens) Type() string                 { return TypeMsgRedeemTokens }
+func (msg MsgRedeemTokens) GetSigners() []sdk.AccAddress { return mustOwnerSigners(msg.Owner) }
+func (msg MsgRedeemTokens) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRedeemTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "redeem amount must be positive")
+	}
+	return nil
+}
+
+// MsgBlockAddress adds Address to the Denom asset's blocked list.
+type MsgBlockAddress struct {
+	Owner   string `json:"owner"`
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+}
+
+func (msg MsgBlockAddress) Route() string                { return RouterKey }
+func (msg MsgBlockAddress) Type() string                 { return TypeMsgBlockAddress }
+func (msg MsgBlockAddress) GetSigners() []sdk.AccAddress { return mustOwnerSigners(msg.Owner) }
+func (msg MsgBlockAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgBlockAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "invalid address to block")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// MsgUnblockAddress removes Address from the Denom asset's blocked list.
+type MsgUnblockAddress struct {
+	Owner   string `json:"owner"`
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+}
+
+func (msg MsgUnblockAddress) Route() string                { return RouterKey }
+func (msg MsgUnblockAddress) Type() string                 { return TypeMsgUnblockAddr }
+func (msg MsgUnblockAddress) GetSigners() []sdk.AccAddress { return mustOwnerSigners(msg.Owner) }
+func (msg MsgUnblockAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnblockAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "invalid address to unblock")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// MsgSetPauseStatus pauses or unpauses every transfer of Denom.
+type MsgSetPauseStatus struct {
+	Owner  string `json:"owner"`
+	Denom  string `json:"denom"`
+	Paused bool   `json:"paused"`
+}
+
+func (msg MsgSetPauseStatus) Route() string                { return RouterKey }
+func (msg MsgSetPauseStatus) Type() string                 { return TypeMsgSetPauseState }
+func (msg MsgSetPauseStatus) GetSigners() []sdk.AccAddress { return mustOwnerSigners(msg.Owner) }
+func (msg MsgSetPauseStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPauseStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
@@ -0,0 +1,18 @@
+package types
+
+import "context"
+
+// MsgServer is the server API for the issuance module's Msg service.
+type MsgServer interface {
+	IssueTokens(context.Context, *MsgIssueTokens) (*MsgIssueTokensResponse, error)
+	RedeemTokens(context.Context, *MsgRedeemTokens) (*MsgRedeemTokensResponse, error)
+	BlockAddress(context.Context, *MsgBlockAddress) (*MsgBlockAddressResponse, error)
+	UnblockAddress(context.Context, *MsgUnblockAddress) (*MsgUnblockAddressResponse, error)
+	SetPauseStatus(context.Context, *MsgSetPauseStatus) (*MsgSetPauseStatusResponse, error)
+}
+
+type MsgIssueTokensResponse struct{}
+type MsgRedeemTokensResponse struct{}
+type MsgBlockAddressResponse struct{}
+type MsgUnblockAddressResponse struct{}
+type MsgSetPauseStatusResponse struct{}